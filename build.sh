@@ -3,22 +3,16 @@
 set -e
 
 function main() {
-  if ! type >/dev/null 2>&1 nex; then
-    echo "# 'nex' command not found"
-    echo "# run below command"
-    echo "go get -u github.com/blynn/nex"
-    return 1
-  fi
   if ! type >/dev/null 2>&1 goyacc; then
     echo "# 'goyacc' command not found"
     echo "# run below command"
     echo "go get -u golang.org/x/tools/cmd/goyacc"
     return 1
   fi
-  echo '# [nex] processing...'
-  nex lexer.nex
+  pushd >/dev/null internal/syntax
   echo '# [goyacc] processing...'
   goyacc -o paser.y.go -v parser.y.output parser.y
+  popd >/dev/null
   go build -o goenkins-format
 }
 main "$@"
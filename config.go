@@ -0,0 +1,110 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/umaumax/goenkins-format/format"
+	"github.com/umaumax/goenkins-format/internal/config"
+	"github.com/umaumax/goenkins-format/internal/editorconfig"
+)
+
+// configLoader finds the nearest config files of input files and caches them
+type configLoader struct {
+	mu      sync.Mutex
+	configs map[string]*config.Config
+	// NOTE: names of the flags specified at command line, which overwrite config files
+	setFlags map[string]bool
+}
+
+func newConfigLoader() *configLoader {
+	l := &configLoader{
+		configs:  map[string]*config.Config{},
+		setFlags: map[string]bool{},
+	}
+	flag.Visit(func(f *flag.Flag) {
+		l.setFlags[f.Name] = true
+	})
+	return l
+}
+
+// load returns the config of dir or nil if there is no config file
+func (l *configLoader) load(dir string) (*config.Config, error) {
+	path, err := config.Find(dir)
+	if err != nil || path == "" {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if c, ok := l.configs[path]; ok {
+		return c, nil
+	}
+	c, err := config.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	l.configs[path] = c
+	return c, nil
+}
+
+// options returns the formatting options of inputFile
+// NOTE: the priority is flags > config file > .editorconfig
+func (l *configLoader) options(inputFile string) (format.Options, error) {
+	dir := "."
+	if inputFile != "-" {
+		dir = filepath.Dir(inputFile)
+	}
+	var opts format.Options
+	c, err := l.load(dir)
+	if err != nil {
+		return opts, err
+	}
+	if inputFile != "-" {
+		props, err := editorconfig.Find(inputFile)
+		if err != nil {
+			return opts, err
+		}
+		// NOTE: the indent of .editorconfig is ignored as a whole when flags or the config file set the indent
+		// e.g. `indent_size = 4` with `-indent_style tab`
+		if l.setFlags["indent_num"] || l.setFlags["indent_style"] || c != nil && (c.IndentWidth != nil || c.IndentStyle != "") {
+			props = props.WithoutIndent()
+		}
+		props.Apply(&opts)
+	}
+	if c != nil {
+		c.Apply(&opts)
+	}
+	if l.setFlags["indent_num"] {
+		if indentSapceNum < 0 {
+			return opts, fmt.Errorf("indent_num must not be negative")
+		}
+		opts.IndentSpaceNum = indentSapceNum
+	}
+	if l.setFlags["indent_style"] {
+		opts.IndentStyle = indentStyle
+	}
+	if l.setFlags["quote_style"] {
+		opts.QuoteStyle = quoteStyle
+	}
+	if l.setFlags["trailing_comma"] {
+		opts.TrailingComma = trailingComma
+	}
+	if l.setFlags["max_blank_lines"] {
+		if maxBlankLines < 0 {
+			return opts, fmt.Errorf("max_blank_lines must not be negative")
+		}
+		opts.MaxBlankLines = maxBlankLines
+		if maxBlankLines == 0 {
+			opts.MaxBlankLines = format.NoBlankLines
+		}
+	}
+	if l.setFlags["max_line_width"] {
+		opts.MaxLineWidth = maxLineWidth
+	}
+	if l.setFlags["blank_line_between_sections"] {
+		opts.BlankLineBetweenSections = sectionBlank
+	}
+	return opts, nil
+}
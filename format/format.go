@@ -0,0 +1,176 @@
+// Package format formats jenkins declarative pipeline (Jenkinsfile) source code.
+package format
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/umaumax/goenkins-format/internal/syntax"
+)
+
+const DefaultIndentSpaceNum = 2
+
+const DefaultMaxBlankLines = 1
+
+// NoBlankLines is Options.MaxBlankLines to remove all blank lines because 0 is DefaultMaxBlankLines
+const NoBlankLines = -1
+
+const DefaultMaxLineWidth = 120
+
+// values of Options.QuoteStyle
+const (
+	QuotePreserve = "preserve"
+	// NOTE: strings including quotes or '$' are not converted
+	QuoteSingle = "single"
+	QuoteDouble = "double"
+)
+
+// values of Options.TrailingComma
+const (
+	TrailingCommaPreserve = "preserve"
+	// NOTE: add trailing commas to multi-line list and map literals
+	TrailingCommaAlways = "always"
+	TrailingCommaNever  = "never"
+)
+
+// values of Options.IndentStyle
+const (
+	IndentSpace = "space"
+	// NOTE: IndentSpaceNum is ignored
+	IndentTab = "tab"
+)
+
+// values of Options.EndOfLine
+const (
+	EndOfLineLF   = "lf"
+	EndOfLineCRLF = "crlf"
+	EndOfLineCR   = "cr"
+)
+
+// values of Options.FinalNewline
+const (
+	// NOTE: keep the final newline if the input has it
+	FinalNewlinePreserve = "preserve"
+	FinalNewlineInsert   = "insert"
+	FinalNewlineRemove   = "remove"
+)
+
+// Options controls the formatting
+type Options struct {
+	// IndentSpaceNum is the number of spaces of indent (DefaultIndentSpaceNum if 0)
+	IndentSpaceNum int
+	// IndentStyle is the character of indent (IndentSpace if "")
+	IndentStyle string
+	// EndOfLine is the newline of the output (EndOfLineLF if "")
+	EndOfLine string
+	// FinalNewline is the newline policy at the end of the output (FinalNewlinePreserve if "")
+	FinalNewline string
+	// QuoteStyle is the quote of simple string literals (QuotePreserve if "")
+	QuoteStyle string
+	// TrailingComma is the trailing comma policy of list and map literals (TrailingCommaPreserve if "")
+	TrailingComma string
+	// MaxBlankLines is the maximum number of consecutive blank lines (DefaultMaxBlankLines if 0, no blank lines if NoBlankLines)
+	MaxBlankLines int
+	// MaxLineWidth is the width which arguments, list and map literals and binary expressions are broken at (DefaultMaxLineWidth if 0)
+	MaxLineWidth int
+	// BlankLineBetweenSections inserts a blank line between the sections of `pipeline` and between stages
+	BlankLineBetweenSections bool
+	// Filename is only used in error messages
+	Filename string
+}
+
+// Validate reports an error of unknown option values
+func (opts Options) Validate() error {
+	if opts.IndentSpaceNum < 0 {
+		return fmt.Errorf("indent space num must not be negative")
+	}
+	if opts.MaxBlankLines < 0 && opts.MaxBlankLines != NoBlankLines {
+		return fmt.Errorf("max blank lines must not be negative")
+	}
+	if opts.MaxLineWidth < 0 {
+		return fmt.Errorf("max line width must not be negative")
+	}
+	switch opts.QuoteStyle {
+	case "", QuotePreserve, QuoteSingle, QuoteDouble:
+	default:
+		return fmt.Errorf("unknown quote style %q", opts.QuoteStyle)
+	}
+	switch opts.TrailingComma {
+	case "", TrailingCommaPreserve, TrailingCommaAlways, TrailingCommaNever:
+	default:
+		return fmt.Errorf("unknown trailing comma policy %q", opts.TrailingComma)
+	}
+	switch opts.IndentStyle {
+	case "", IndentSpace, IndentTab:
+	default:
+		return fmt.Errorf("unknown indent style %q", opts.IndentStyle)
+	}
+	switch opts.EndOfLine {
+	case "", EndOfLineLF, EndOfLineCRLF, EndOfLineCR:
+	default:
+		return fmt.Errorf("unknown end of line %q", opts.EndOfLine)
+	}
+	switch opts.FinalNewline {
+	case "", FinalNewlinePreserve, FinalNewlineInsert, FinalNewlineRemove:
+	default:
+		return fmt.Errorf("unknown final newline policy %q", opts.FinalNewline)
+	}
+	return nil
+}
+
+// SyntaxError is returned by Format when the input can not be parsed
+// Line and Column start at 1
+type SyntaxError struct {
+	Filename string
+	Line     int
+	Column   int
+	Msg      string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Filename == "" {
+		return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Msg)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", e.Filename, e.Line, e.Column, e.Msg)
+}
+
+// Format formats src and returns the formatted source code
+// It is safe to call Format from multiple goroutines
+func Format(src []byte, opts Options) ([]byte, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	// NOTE: the newlines in multi-line strings and comments are also written by Options.EndOfLine
+	src = bytes.ReplaceAll(src, []byte("\r\n"), []byte("\n"))
+	src = bytes.ReplaceAll(src, []byte("\r"), []byte("\n"))
+	file, err := syntax.Parse(src)
+	if err != nil {
+		return nil, &SyntaxError{Filename: opts.Filename, Line: err.Line, Column: err.Column, Msg: err.Msg}
+	}
+
+	indentSpaceNum := opts.IndentSpaceNum
+	if indentSpaceNum == 0 {
+		indentSpaceNum = DefaultIndentSpaceNum
+	}
+	var out outputStream
+	out.SetIndentSpaceNum(indentSpaceNum)
+	out.SetIndentTab(opts.IndentStyle == IndentTab)
+	printFile(&out, file, opts)
+	output := out.String()
+	switch opts.FinalNewline {
+	case FinalNewlineInsert:
+		if output != "" && !strings.HasSuffix(output, "\n") {
+			output += "\n"
+		}
+	case FinalNewlineRemove:
+		output = strings.TrimRight(output, "\n")
+	}
+	switch opts.EndOfLine {
+	case EndOfLineCRLF:
+		output = strings.ReplaceAll(output, "\n", "\r\n")
+	case EndOfLineCR:
+		output = strings.ReplaceAll(output, "\n", "\r")
+	}
+	return []byte(output), nil
+}
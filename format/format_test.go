@@ -0,0 +1,163 @@
+package format
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// NOTE: the golden tests of test/input are run by test.sh with the default options,
+// these tests check that the output of other options can be parsed and formatted again without changes
+func TestFormatIdempotent(t *testing.T) {
+	files, err := filepath.Glob("../test/input/*/*.groovy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no test input files")
+	}
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{"default", Options{}},
+		{"narrow", Options{MaxLineWidth: 30}},
+		{"narrow tab", Options{MaxLineWidth: 30, IndentStyle: IndentTab}},
+		{"sections", Options{BlankLineBetweenSections: true}},
+		{"no blank lines", Options{MaxBlankLines: NoBlankLines}},
+	}
+	for _, tt := range tests {
+		for _, file := range files {
+			src, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			first, err := Format(src, tt.opts)
+			if err != nil {
+				t.Errorf("%s: %s: %v", tt.name, file, err)
+				continue
+			}
+			second, err := Format(first, tt.opts)
+			if err != nil {
+				t.Errorf("%s: %s: the output can't be parsed: %v\n%s", tt.name, file, err, first)
+				continue
+			}
+			if !bytes.Equal(first, second) {
+				t.Errorf("%s: %s: the output changes by formatting again\nfirst:\n%s\nsecond:\n%s", tt.name, file, first, second)
+			}
+		}
+	}
+}
+
+func TestFormatEndOfLine(t *testing.T) {
+	src := "def s = \"\"\"x\r\ny\"\"\"\r\n/*\r\n * comment\r\n */\r\necho s\r\n"
+	tests := []struct {
+		endOfLine string
+		want      string
+	}{
+		{EndOfLineCRLF, src},
+		{"", strings.ReplaceAll(src, "\r\n", "\n")},
+		{EndOfLineCR, strings.ReplaceAll(src, "\r\n", "\r")},
+	}
+	for _, tt := range tests {
+		for _, in := range []string{src, tt.want} {
+			got, err := Format([]byte(in), Options{EndOfLine: tt.endOfLine})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Format(%q) with %q = %q, want %q", in, tt.endOfLine, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestOutputStreamWidth(t *testing.T) {
+	tests := []struct {
+		indentTab bool
+		text      string
+		want      int
+	}{
+		{false, "    foo(", 8},
+		{true, "\t\tfoo(", 8},
+		{true, "\tあい", 4},
+	}
+	for _, tt := range tests {
+		s := outputStream{indentSapceNum: 2, indentTab: tt.indentTab}
+		s.Write(0, tt.text)
+		if got := s.Width(0); got != tt.want {
+			t.Errorf("Width() of %q = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestFormatError(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"x = foo(\n", "2:1: syntax error: unexpected end of file, expecting newline or ')'"},
+		{"x = a[1\n", "2:1: syntax error: unexpected end of file, expecting newline or ']'"},
+		{"x = 1 -> 2\n", "1:7: syntax error: unexpected '->'"},
+		{"foo(a b)\n", "1:7: syntax error: unexpected IDENT \"b\", expecting newline or ')'"},
+		{"x = [1 2]\n", "1:8: syntax error: unexpected NUMBER \"2\", expecting newline or ']'"},
+	}
+	for _, tt := range tests {
+		_, err := Format([]byte(tt.src), Options{})
+		if err == nil {
+			t.Errorf("Format(%q) succeeded, want error %q", tt.src, tt.want)
+			continue
+		}
+		if got := err.Error(); got != tt.want {
+			t.Errorf("Format(%q) error = %q, want %q", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		opts    Options
+		wantErr bool
+	}{
+		{Options{}, false},
+		{Options{IndentSpaceNum: 4, MaxBlankLines: NoBlankLines}, false},
+		{Options{IndentSpaceNum: -1}, true},
+		{Options{MaxBlankLines: -2}, true},
+		{Options{MaxLineWidth: -1}, true},
+		{Options{QuoteStyle: "backquote"}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.opts.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%+v Validate() = %v, want error %v", tt.opts, err, tt.wantErr)
+		}
+	}
+	// NOTE: Format doesn't panic with invalid options
+	if _, err := Format([]byte("x = 1\n"), Options{IndentSpaceNum: -1}); err == nil {
+		t.Error("Format() with negative IndentSpaceNum succeeded")
+	}
+}
+
+func TestFormatBlankLines(t *testing.T) {
+	src := "\n\npipeline {\n  agent any\n\n\n\n  stages {\n  }\n}\n"
+	tests := []struct {
+		name string
+		opts Options
+		want string
+	}{
+		{"default", Options{}, "pipeline {\n  agent any\n\n  stages {\n  }\n}\n"},
+		{"two", Options{MaxBlankLines: 2}, "pipeline {\n  agent any\n\n\n  stages {\n  }\n}\n"},
+		{"none", Options{MaxBlankLines: NoBlankLines}, "pipeline {\n  agent any\n  stages {\n  }\n}\n"},
+	}
+	for _, tt := range tests {
+		got, err := Format([]byte(src), tt.opts)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+type outputStream struct {
+	output         string
+	outputNewFlag  bool
+	indentSapceNum int
+	indentTab      bool
+	// NOTE: indent level of the current line
+	lineIndentLevel int
+}
+
+func (s *outputStream) Truncate() {
+	s.output = ""
+	s.outputNewFlag = false
+	s.lineIndentLevel = 0
+}
+
+func (s *outputStream) SetIndentSpaceNum(indentSapceNum int) {
+	s.indentSapceNum = indentSapceNum
+}
+
+func (s *outputStream) SetIndentTab(indentTab bool) {
+	s.indentTab = indentTab
+}
+
+func (s *outputStream) SetNewLineFlag() {
+	s.outputNewFlag = true
+}
+
+func (s *outputStream) LineIndentLevel() int {
+	return s.lineIndentLevel
+}
+
+// Column returns the width of the current line, including the indent to be written at the line start
+func (s *outputStream) Column(indent_level int) int {
+	if s.AtLineStart() {
+		return utf8.RuneCountInString(s.genIndent(indent_level))
+	}
+	return utf8.RuneCountInString(s.output[strings.LastIndex(s.output, "\n")+1:])
+}
+
+// Width returns the display width of the current line like Column but a tab is counted as the indent width
+func (s *outputStream) Width(indent_level int) int {
+	line := s.output[strings.LastIndex(s.output, "\n")+1:]
+	if s.AtLineStart() {
+		line = s.genIndent(indent_level)
+	}
+	return s.TextWidth(line)
+}
+
+// TextWidth returns the display width of text, a tab is counted as the indent width
+func (s *outputStream) TextWidth(text string) int {
+	return utf8.RuneCountInString(text) + strings.Count(text, "\t")*(s.indentSapceNum-1)
+}
+
+func (s *outputStream) AtLineStart() bool {
+	return s.outputNewFlag || s.output == ""
+}
+
+func (s *outputStream) TrimSpace() {
+	s.output = strings.TrimRight(s.output, " ")
+	if strings.HasSuffix(s.output, "\n") {
+		s.output = strings.TrimRight(s.output, "\n") + "\n"
+	}
+}
+
+// TrimLineSpace removes the trailing spaces of the current line
+// NOTE: TrimSpace also removes blank lines
+func (s *outputStream) TrimLineSpace() {
+	s.output = strings.TrimRight(s.output, " ")
+}
+
+func (s *outputStream) Write(indent_level int, args ...interface{}) {
+	if s.outputNewFlag {
+		s.output += fmt.Sprint(s.genIndent(indent_level))
+		s.outputNewFlag = false
+		s.lineIndentLevel = indent_level
+	}
+	s.output += fmt.Sprint(args...)
+}
+func (s *outputStream) genIndent(indent_level int) string {
+	if s.indentTab {
+		return strings.Repeat("\t", indent_level)
+	}
+	return strings.Repeat(strings.Repeat(" ", s.indentSapceNum), indent_level)
+}
+
+func (s *outputStream) String() string {
+	return s.output
+}
@@ -0,0 +1,964 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/umaumax/goenkins-format/internal/syntax"
+)
+
+// NOTE: printer walks the syntax tree built by internal/syntax/parser.y and writes formatted code to outputStream
+
+type printer struct {
+	out          *outputStream
+	opts         Options
+	indent_level int
+	// NOTE: inline comments already written by the nodes they are attached to
+	written map[*syntax.InlineComment]bool
+	// NOTE: the number of consecutive blank lines written just before
+	blankLines int
+	// NOTE: measuring the width of a node written on one line, which doesn't break long lines
+	flat bool
+	// NOTE: a block is written while measuring e.g. a closure
+	flatBlock bool
+}
+
+func printFile(out *outputStream, file *syntax.File, opts Options) {
+	p := printer{out: out, opts: opts}
+	stmts := file.Stmts
+	// NOTE: blank lines at the beginning of the file are removed
+	for len(stmts) > 0 {
+		if b, ok := stmts[0].(*syntax.LineBreak); !ok || !isBlankLine(b) {
+			break
+		}
+		stmts = stmts[1:]
+	}
+	p.stmts(stmts)
+	out.TrimSpace()
+}
+
+func (p *printer) write(args ...interface{}) {
+	p.out.Write(p.indent_level, args...)
+}
+
+// space writes a separator space unless at the start of a line
+func (p *printer) space() {
+	if !p.out.AtLineStart() {
+		p.write(" ")
+	}
+}
+
+// comments writes inline comments before the node they are attached to
+func (p *printer) comments(comments []*syntax.InlineComment) {
+	for _, c := range comments {
+		if p.written == nil {
+			p.written = map[*syntax.InlineComment]bool{}
+		}
+		p.comment(c.Text)
+		p.write(" ")
+		p.written[c] = true
+	}
+}
+
+// comment writes comments re-indenting the continuation lines of block comments
+// relative to the column where the comment starts
+func (p *printer) comment(text string) {
+	lines := strings.Split(text, "\n")
+	p.write(lines[0])
+	if len(lines) == 1 {
+		return
+	}
+	indent := p.out.genIndent(p.out.LineIndentLevel())
+	column := p.out.Column(p.indent_level) - utf8.RuneCountInString(lines[0])
+	prefix := indent + strings.Repeat(" ", column-utf8.RuneCountInString(indent))
+	for _, line := range lines[1:] {
+		p.out.Write(0, "\n")
+		if strings.TrimSpace(line) != "" {
+			p.out.Write(0, prefix)
+		}
+		p.out.Write(0, line)
+	}
+}
+
+func (p *printer) lineBreak(b *syntax.LineBreak) {
+	if isBlankLine(b) && p.out.AtLineStart() {
+		maxBlankLines := p.opts.MaxBlankLines
+		switch maxBlankLines {
+		case 0:
+			maxBlankLines = DefaultMaxBlankLines
+		case NoBlankLines:
+			maxBlankLines = 0
+		}
+		if p.blankLines >= maxBlankLines {
+			return
+		}
+		p.blankLines++
+	} else {
+		p.blankLines = 0
+	}
+	// NOTE: inline comments whose nodes are not written e.g. dropped by rewriting are moved to the end of line
+	for _, c := range b.Inline {
+		if !p.written[c] {
+			p.space()
+			p.comment(c.Text)
+		}
+	}
+	if b.Comment != "" {
+		p.space()
+		p.comment(b.Comment)
+	} else {
+		p.out.TrimLineSpace()
+	}
+	p.out.Write(0, "\n")
+	p.out.SetNewLineFlag()
+}
+
+// isBlankLine reports whether b is an empty line if it follows another line break
+func isBlankLine(b *syntax.LineBreak) bool {
+	return b.Comment == "" && len(b.Inline) == 0
+}
+
+// splitCaseBody splits the statements of case into the body and the following lines
+// which are only comments or blank e.g. `// comment` before the next `case 2:`
+func splitCaseBody(stmts []syntax.Stmt) ([]syntax.Stmt, []syntax.Stmt) {
+	// NOTE: the line break after the last statement or `case x:` ends the line
+	end := 1
+	for i, s := range stmts {
+		if _, ok := s.(*syntax.LineBreak); !ok {
+			end = i + 2
+		}
+	}
+	if end >= len(stmts) {
+		return stmts, nil
+	}
+	return stmts[:end], stmts[end:]
+}
+
+// trimBlankLines removes blank lines right after '{' and before '}'
+func trimBlankLines(stmts []syntax.Stmt) []syntax.Stmt {
+	first, last := len(stmts), -1
+	for i, s := range stmts {
+		if _, ok := s.(*syntax.LineBreak); !ok {
+			if first == len(stmts) {
+				first = i
+			}
+			last = i
+		}
+	}
+	var trimmed []syntax.Stmt
+	for i, s := range stmts {
+		if b, ok := s.(*syntax.LineBreak); ok && isBlankLine(b) {
+			if i > 0 && i < first && len(trimmed) == 1 {
+				// NOTE: a blank line after comments is kept e.g. a license header
+				continue
+			}
+			if i > last+1 && blankUntilEnd(stmts[i:]) {
+				continue
+			}
+		}
+		trimmed = append(trimmed, s)
+	}
+	return trimmed
+}
+
+// isOneLine reports whether the statements of a block are written on the line of '{' e.g. `{ echo 'a' }`
+func isOneLine(stmts []syntax.Stmt) bool {
+	for _, s := range stmts {
+		if _, ok := s.(*syntax.LineBreak); ok {
+			return false
+		}
+	}
+	return len(stmts) > 0
+}
+
+// breakLines writes the statements of a one line block on their own lines
+func breakLines(stmts []syntax.Stmt) []syntax.Stmt {
+	broken := []syntax.Stmt{&syntax.LineBreak{}}
+	for i, s := range stmts {
+		broken = append(broken, s)
+		if i+1 < len(stmts) {
+			if _, ok := stmts[i+1].(*syntax.Semicolon); ok {
+				continue
+			}
+		}
+		broken = append(broken, &syntax.LineBreak{})
+	}
+	return broken
+}
+
+// blankUntilEnd reports whether stmts are all blank lines
+func blankUntilEnd(stmts []syntax.Stmt) bool {
+	for _, s := range stmts {
+		if b, ok := s.(*syntax.LineBreak); !ok || !isBlankLine(b) {
+			return false
+		}
+	}
+	return true
+}
+
+// NOTE: blocks whose statements are separated by Options.BlankLineBetweenSections
+var sectionBlocks = map[string]bool{
+	"pipeline": true,
+	"stages":   true,
+	"parallel": true,
+}
+
+func isSectionBlock(s *syntax.BlockCallStmt) bool {
+	fun, ok := s.Fun.(*syntax.Ident)
+	return ok && s.Args == nil && sectionBlocks[fun.Name]
+}
+
+// separateSections inserts a blank line between statements which are not separated by blank lines
+func separateSections(stmts []syntax.Stmt) []syntax.Stmt {
+	var separated []syntax.Stmt
+	// NOTE: the line breaks since the last statement (-1 before the first statement)
+	breaks := -1
+	blank := false
+	for _, s := range stmts {
+		b, ok := s.(*syntax.LineBreak)
+		if !ok {
+			if breaks > 0 && !blank {
+				// NOTE: after the line break which ends the previous statement
+				i := len(separated) - breaks + 1
+				separated = append(separated[:i], append([]syntax.Stmt{&syntax.LineBreak{}}, separated[i:]...)...)
+			}
+			breaks, blank = 0, false
+		} else if breaks >= 0 {
+			blank = blank || breaks > 0 && isBlankLine(b)
+			breaks++
+		}
+		separated = append(separated, s)
+	}
+	return separated
+}
+
+func (p *printer) lineBreaks(breaks []*syntax.LineBreak) {
+	for _, b := range breaks {
+		p.lineBreak(b)
+	}
+}
+
+func (p *printer) stmts(stmts []syntax.Stmt) {
+	for _, s := range stmts {
+		switch s := s.(type) {
+		case *syntax.LineBreak:
+			p.lineBreak(s)
+		case *syntax.Semicolon:
+			p.write(";")
+		default:
+			p.space()
+			p.stmt(s)
+		}
+	}
+}
+
+func (p *printer) block(b *syntax.Block) {
+	indent := p.indent_level
+	if !p.out.AtLineStart() {
+		// NOTE: the body is indented relative to the line of '{' e.g. `[a: {`, `foo(x, {`
+		p.indent_level = p.out.LineIndentLevel()
+	}
+	p.stmtBlock(b)
+	p.indent_level = indent
+}
+
+// stmtBlock writes the block of a statement indented relative to the statement
+// NOTE: not to the continuation lines of the condition e.g. `if (a &&\n  b) {`
+func (p *printer) stmtBlock(b *syntax.Block) {
+	if p.flat {
+		p.flatBlock = true
+	}
+	stmts := trimBlankLines(b.Stmts)
+	if !p.flat && isOneLine(stmts) && !p.fits(func(flat *printer) { flat.block(b) }) {
+		stmts = breakLines(stmts)
+	}
+	p.write("{")
+	p.indent_level++
+	if b.Params != nil {
+		p.space()
+		if len(b.Params.List) > 0 || len(b.Params.Trailing) > 0 {
+			p.exprList("", b.Params, "")
+			p.space()
+		}
+		p.write("->")
+	}
+	p.stmts(stmts)
+	p.indent_level--
+	p.space()
+	p.comments(b.Closing)
+	p.write("}")
+}
+
+// body writes the body of if statements and loops, which is a block or a statement without braces
+func (p *printer) body(s syntax.Stmt) {
+	if b, ok := s.(*syntax.Block); ok {
+		p.stmtBlock(b)
+		return
+	}
+	p.stmt(s)
+}
+
+func (p *printer) stmt(s syntax.Stmt) {
+	switch s := s.(type) {
+	case *syntax.Block:
+		p.block(s)
+	case *syntax.PackageStmt:
+		p.write("package ", s.Path)
+	case *syntax.ImportStmt:
+		p.write("import ")
+		if s.Static {
+			p.write("static ")
+		}
+		p.write(s.Path)
+	case *syntax.Annotation:
+		p.write("@", s.Name)
+		if s.Args != nil {
+			p.exprList("(", s.Args, ")")
+		}
+		if s.Stmt != nil {
+			p.write(" ")
+			p.stmt(s.Stmt)
+		}
+	case *syntax.DeclStmt:
+		p.words(s.Modifiers, s.Type, s.Name)
+		if s.Value != nil {
+			p.write(" = ")
+			p.expr(s.Value)
+		}
+	case *syntax.FuncDecl:
+		p.words(s.Modifiers, s.Type, s.Name)
+		p.exprList("(", s.Params, ")")
+		if s.Body != nil {
+			p.write(" ")
+			p.stmtBlock(s.Body)
+		}
+	case *syntax.ClassDecl:
+		p.words(s.Modifiers, s.Kind, s.Name)
+		if len(s.Extends) > 0 {
+			p.write(" extends ", strings.Join(s.Extends, ", "))
+		}
+		if len(s.Implements) > 0 {
+			p.write(" implements ", strings.Join(s.Implements, ", "))
+		}
+		p.write(" ")
+		if s.Constants != nil {
+			p.enumBody(s)
+		} else {
+			p.stmtBlock(s.Body)
+		}
+	case *syntax.ReturnStmt:
+		p.write("return")
+		if s.X != nil {
+			p.write(" ")
+			p.expr(s.X)
+		}
+	case *syntax.ThrowStmt:
+		p.write("throw ")
+		p.expr(s.X)
+	case *syntax.AssignStmt:
+		p.expr(s.Lhs)
+		p.write(" = ")
+		p.expr(s.Rhs)
+	case *syntax.ExprStmt:
+		p.expr(s.X)
+	case *syntax.CommandStmt:
+		if s.Recv != nil {
+			p.stmt(s.Recv)
+			p.write(" ")
+		}
+		if s.Fun != nil {
+			p.expr(s.Fun)
+		} else {
+			p.write(s.Name)
+		}
+		if s.Args != nil {
+			// NOTE: arguments on the following lines are indented
+			p.write(" ")
+			p.args("", s.Args, "")
+		}
+	case *syntax.BlockCallStmt:
+		p.expr(s.Fun)
+		if s.Args != nil {
+			p.args("(", s.Args, ")")
+		}
+		p.write(" ")
+		if p.opts.BlankLineBetweenSections && isSectionBlock(s) {
+			p.stmtBlock(&syntax.Block{Stmts: separateSections(s.Body.Stmts), Closing: s.Body.Closing})
+			break
+		}
+		p.stmtBlock(s.Body)
+	case *syntax.IfStmt:
+		p.write("if ")
+		p.expr(s.Cond)
+		p.write(" ")
+		p.body(s.Then)
+		if s.Else != nil {
+			p.write(" else ")
+			p.stmt(s.Else)
+		}
+	case *syntax.ForInStmt:
+		p.write("for (")
+		if s.Type != "" {
+			p.write(s.Type, " ")
+		}
+		p.write(s.Var, " in ")
+		p.expr(s.X)
+		p.write(") ")
+		p.body(s.Body)
+	case *syntax.ForStmt:
+		p.write("for (")
+		p.stmt(s.Init)
+		p.write("; ")
+		p.expr(s.Cond)
+		p.write("; ")
+		p.expr(s.Post)
+		p.write(") ")
+		p.body(s.Body)
+	case *syntax.WhileStmt:
+		p.write("while ")
+		p.expr(s.Cond)
+		p.write(" ")
+		p.body(s.Body)
+	case *syntax.DoWhileStmt:
+		p.write("do ")
+		p.stmtBlock(s.Body)
+		p.write(" while ")
+		p.expr(s.Cond)
+	case *syntax.LabeledStmt:
+		p.write(s.Label, ":")
+		p.lineBreaks(s.Breaks)
+		p.space()
+		p.stmt(s.Stmt)
+	case *syntax.BranchStmt:
+		p.words(s.Tok, s.Label)
+	case *syntax.SwitchStmt:
+		p.write("switch ")
+		p.expr(s.Tag)
+		p.write(" {")
+		p.indent_level++
+		p.lineBreaks(s.Breaks)
+		for i, c := range s.Cases {
+			body := c.Body
+			var next []syntax.Stmt
+			if i < len(s.Cases)-1 {
+				// NOTE: comments before the next case are written at the level of case
+				body, next = splitCaseBody(body)
+			}
+			p.space()
+			if c.Value != nil {
+				p.write("case ")
+				p.expr(c.Value)
+				p.write(":")
+			} else {
+				p.write("default:")
+			}
+			// NOTE: statements of case are indented one more level than case
+			p.indent_level++
+			p.stmts(body)
+			p.indent_level--
+			p.stmts(next)
+		}
+		p.indent_level--
+		p.space()
+		p.write("}")
+	case *syntax.TryStmt:
+		p.write("try ")
+		p.stmtBlock(s.Body)
+		for _, c := range s.Catches {
+			p.write(" catch (")
+			if len(c.Types) > 0 {
+				p.write(strings.Join(c.Types, " | "), " ")
+			}
+			p.write(c.Name, ") ")
+			p.stmtBlock(c.Body)
+		}
+		if s.Finally != nil {
+			p.write(" finally ")
+			p.stmtBlock(s.Finally)
+		}
+	default:
+		panic(fmt.Sprintf("printer: unexpected statement %T", s))
+	}
+}
+
+// words writes non-empty words separated by spaces e.g. modifiers, type and name of declarations
+func (p *printer) words(words ...string) {
+	first := true
+	for _, w := range words {
+		if w == "" {
+			continue
+		}
+		if !first {
+			p.write(" ")
+		}
+		p.write(w)
+		first = false
+	}
+}
+
+// enumBody writes the constants and the members of enum e.g. `{ A, B; members }`
+func (p *printer) enumBody(s *syntax.ClassDecl) {
+	l := s.Constants
+	p.write("{")
+	p.indent_level++
+	for i, x := range l.List {
+		if i > 0 {
+			p.write(",")
+		}
+		if len(l.Breaks[i]) > 0 {
+			p.lineBreaks(l.Breaks[i])
+		} else {
+			p.write(" ")
+		}
+		p.expr(x)
+	}
+	if l.Comma {
+		p.write(",")
+	}
+	p.lineBreaks(l.Trailing)
+	if len(s.Body.Stmts) > 0 {
+		p.write(";")
+		p.stmts(s.Body.Stmts)
+	}
+	p.indent_level--
+	p.space()
+	p.write("}")
+}
+
+// exprList writes comma separated expressions enclosed by open and close brackets
+func (p *printer) exprList(open string, l *syntax.ExprList, close string) {
+	indent := p.indent_level
+	if !p.out.AtLineStart() {
+		// NOTE: the elements are indented relative to the line of the open bracket e.g. `build job: 'x', parameters: [`
+		p.indent_level = p.out.LineIndentLevel()
+	}
+	p.write(open)
+	p.indent_level++
+	for i, x := range l.List {
+		if i > 0 {
+			p.write(",")
+		}
+		if len(l.Breaks[i]) > 0 {
+			p.lineBreaks(l.Breaks[i])
+		} else if i > 0 {
+			p.write(" ")
+		}
+		p.expr(x)
+	}
+	if l.Comma {
+		p.write(",")
+	}
+	p.lineBreaks(l.Trailing)
+	p.indent_level--
+	p.closing(l)
+	p.write(close)
+	p.indent_level = indent
+}
+
+// closing writes inline comments before the closing bracket e.g. `foo(x /* comment */)`
+func (p *printer) closing(l *syntax.ExprList) {
+	if len(l.Closing) == 0 {
+		return
+	}
+	if len(l.List) > 0 {
+		p.space()
+	}
+	p.comments(l.Closing)
+	p.out.TrimSpace()
+}
+
+// maxLineWidth returns Options.MaxLineWidth or the default
+func (p *printer) maxLineWidth() int {
+	if p.opts.MaxLineWidth == 0 {
+		return DefaultMaxLineWidth
+	}
+	return p.opts.MaxLineWidth
+}
+
+// fits reports whether the first line written by print fits in the max line width from the current column
+// NOTE: the nodes are written on one line except the line breaks of the source e.g. closures
+func (p *printer) fits(print func(flat *printer)) bool {
+	return p.fitsFlat(p.measure(print))
+}
+
+// measure writes the nodes by print on one line
+func (p *printer) measure(print func(flat *printer)) *printer {
+	flat := &printer{out: &outputStream{indentSapceNum: p.out.indentSapceNum, indentTab: p.out.indentTab}, opts: p.opts, indent_level: p.indent_level, flat: true}
+	print(flat)
+	return flat
+}
+
+// fitsFlat reports whether the first line measured by flat fits in the max line width from the current column
+func (p *printer) fitsFlat(flat *printer) bool {
+	text := flat.out.String()
+	if i := strings.Index(text, "\n"); i >= 0 {
+		text = text[:i]
+	}
+	return p.out.Width(p.indent_level)+p.out.TextWidth(text) <= p.maxLineWidth()
+}
+
+// args writes arguments one per line if they are written on one line in the source and don't fit in the max line width
+// NOTE: the first argument of commands (open is "") stays on the line of the command e.g. `mail to: 'a',`
+func (p *printer) args(open string, l *syntax.ExprList, close string) {
+	if p.flat || len(l.List) == 0 || hasLineBreak(l) || p.fits(func(flat *printer) { flat.exprList(open, l, close) }) {
+		p.exprList(open, l, close)
+		return
+	}
+	broken := &syntax.ExprList{List: l.List, Breaks: make([][]*syntax.LineBreak, len(l.List)), Comma: l.Comma, Closing: l.Closing}
+	for i := range l.List {
+		if i > 0 || open != "" {
+			broken.Breaks[i] = []*syntax.LineBreak{{}}
+		}
+	}
+	if close != "" {
+		broken.Trailing = []*syntax.LineBreak{{}}
+	}
+	p.exprList(open, broken, close)
+}
+
+// hasLineBreak reports whether the list is written on multiple lines in the source
+func hasLineBreak(l *syntax.ExprList) bool {
+	for _, breaks := range l.Breaks {
+		if len(breaks) > 0 {
+			return true
+		}
+	}
+	return len(l.Trailing) > 0
+}
+
+// binary writes a chain of the same binary operator breaking the line after each operator if it doesn't fit in the max line width
+// e.g. `a &&\n  b &&\n  c`
+func (p *printer) binary(x *syntax.BinaryExpr) {
+	operands := []syntax.Expr{x.Y}
+	left := x.X
+	for {
+		y, ok := left.(*syntax.BinaryExpr)
+		if !ok || y.Op != x.Op {
+			break
+		}
+		operands = append([]syntax.Expr{y.Y}, operands...)
+		left = y.X
+	}
+	operands = append([]syntax.Expr{left}, operands...)
+	if p.flat || !isContinuationOperator(x.Op) {
+		p.operands(x.Op, operands)
+		return
+	}
+	// NOTE: the operands with blocks e.g. `xs.sum { it } / n` are not broken but the blocks are
+	// because the width of the operators depends on the lines of the blocks
+	if flat := p.measure(func(flat *printer) { flat.operands(x.Op, operands) }); flat.flatBlock || p.fitsFlat(flat) {
+		p.operands(x.Op, operands)
+		return
+	}
+	indent := p.indent_level
+	if !p.out.AtLineStart() {
+		// NOTE: the operands on the following lines are indented relative to the line of the first operand
+		p.indent_level = p.out.LineIndentLevel()
+	}
+	p.expr(operands[0])
+	p.indent_level++
+	for _, y := range operands[1:] {
+		p.write(" ", x.Op)
+		p.lineBreak(&syntax.LineBreak{})
+		p.expr(y)
+	}
+	p.indent_level = indent
+}
+
+// isContinuationOperator reports whether the line can be broken after op e.g. not after `+=`, `instanceof`
+func isContinuationOperator(op string) bool {
+	for _, o := range syntax.ContinuationOperators {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+// operands writes the operands of a chain of the same binary operator on one line
+func (p *printer) operands(op string, operands []syntax.Expr) {
+	sep := " " + op + " "
+	if op == ".." || op == "..<" {
+		// NOTE: ranges are written without spaces e.g. `1..10`
+		sep = op
+	}
+	for i, y := range operands {
+		if i > 0 {
+			p.write(sep)
+		}
+		p.expr(y)
+	}
+}
+
+func (p *printer) expr(x syntax.Expr) {
+	switch x := x.(type) {
+	case *syntax.Ident:
+		p.comments(x.Comments)
+		p.write(x.Name)
+	case *syntax.BasicLit:
+		p.comments(x.Comments)
+		if x.Kind == syntax.STRING {
+			p.write(p.gstring(p.quote(x.Value)))
+		} else {
+			p.write(x.Value)
+		}
+	case *syntax.ParenExpr:
+		indent := p.indent_level
+		if !p.out.AtLineStart() {
+			// NOTE: the expression is indented relative to the line of '(' like exprList
+			p.indent_level = p.out.LineIndentLevel()
+		}
+		p.write("(")
+		p.indent_level++
+		p.lineBreaks(x.Lparen)
+		p.expr(x.X)
+		p.lineBreaks(x.Rparen)
+		p.indent_level--
+		p.write(")")
+		p.indent_level = indent
+	case *syntax.KeyValue:
+		if x.Key != nil {
+			p.expr(x.Key)
+		} else {
+			p.write("*")
+		}
+		p.write(": ")
+		p.expr(x.Value)
+	case *syntax.NamedArgs:
+		// NOTE: named arguments without parenthesis are not indented
+		p.indent_level--
+		p.exprList("", x.Elems, "")
+		p.indent_level++
+	case *syntax.ListLit:
+		p.collection(x.Elems, "[]")
+	case *syntax.MapLit:
+		p.collection(x.Elems, "[:]")
+	case *syntax.CallExpr:
+		p.expr(x.Fun)
+		if x.Args != nil {
+			p.args("(", x.Args, ")")
+		}
+		if x.Closure != nil {
+			p.write(" ")
+			p.block(x.Closure)
+		}
+	case *syntax.SelectorExpr:
+		p.expr(x.X)
+		p.write(x.Op, x.Sel)
+	case *syntax.IndexExpr:
+		p.expr(x.X)
+		p.exprList("[", x.Index, "]")
+	case *syntax.NewExpr:
+		p.write("new ", x.Type)
+		p.args("(", x.Args, ")")
+	case *syntax.UnaryExpr:
+		p.write(x.Op)
+		// NOTE: `- -x` is not `--x`
+		if y, ok := x.X.(*syntax.UnaryExpr); ok && (x.Op == "-" || x.Op == "+") && strings.HasPrefix(y.Op, x.Op) {
+			p.write(" ")
+		}
+		p.expr(x.X)
+	case *syntax.BinaryExpr:
+		p.binary(x)
+	case *syntax.Param:
+		p.words(x.Type, x.Name)
+		if x.Default != nil {
+			p.write(" = ")
+			p.expr(x.Default)
+		}
+	case *syntax.CondExpr:
+		p.expr(x.Cond)
+		p.write(" ? ")
+		p.expr(x.Then)
+		p.write(" : ")
+		p.expr(x.Else)
+	case *syntax.IncDecExpr:
+		p.expr(x.X)
+		p.write(x.Op)
+	case *syntax.ClosureExpr:
+		p.block(x.Body)
+	case *syntax.CommandExpr:
+		p.stmt(x.Cmd)
+	default:
+		panic(fmt.Sprintf("printer: unexpected expression %T", x))
+	}
+}
+
+// quote converts the quotes of a simple string literal by Options.QuoteStyle
+func (p *printer) quote(s string) string {
+	var from, to string
+	switch p.opts.QuoteStyle {
+	case QuoteSingle:
+		from, to = `"`, `'`
+	case QuoteDouble:
+		from, to = `'`, `"`
+	default:
+		return s
+	}
+	if len(s) < 2 || strings.HasPrefix(s, from+from+from) || !strings.HasPrefix(s, from) || !strings.HasSuffix(s, from) {
+		return s
+	}
+	content := s[1 : len(s)-1]
+	// NOTE: converting these strings needs escape or changes GString interpolation
+	if strings.ContainsAny(content, `'"$`) || strings.Contains(content, "\n") {
+		return s
+	}
+	return to + content + to
+}
+
+// gstring normalizes the whitespaces of the expressions in `${ ... }` of a GString
+func (p *printer) gstring(s string) string {
+	if !strings.HasPrefix(s, `"`) {
+		return s
+	}
+	var b strings.Builder
+	last := 0
+	for _, r := range syntax.Interpolations(s) {
+		b.WriteString(s[last:r[0]])
+		if x, ok := p.interpolation(s[r[0]:r[1]]); ok {
+			b.WriteString(x)
+		} else {
+			b.WriteString(s[r[0]:r[1]])
+		}
+		last = r[1]
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// interpolation formats src of `${src}` if it is a single line expression
+func (p *printer) interpolation(src string) (string, bool) {
+	// NOTE: comments and closures are kept as is
+	if strings.Contains(src, "\n") || strings.Contains(src, "//") || strings.Contains(src, "/*") {
+		return "", false
+	}
+	file, err := syntax.Parse([]byte(src))
+	if err != nil {
+		return "", false
+	}
+	var x syntax.Expr
+	for _, s := range file.Stmts {
+		switch s := s.(type) {
+		case *syntax.LineBreak, *syntax.Semicolon:
+		case *syntax.ExprStmt:
+			if x != nil {
+				return "", false
+			}
+			x = s.X
+		default:
+			return "", false
+		}
+	}
+	if x == nil {
+		return "", false
+	}
+	sub := printer{out: &outputStream{}, opts: p.opts}
+	sub.expr(x)
+	output := sub.out.String()
+	if strings.Contains(output, "\n") {
+		return "", false
+	}
+	return output, true
+}
+
+// collection writes list and map literals on one line if they fit,
+// otherwise one element per line
+func (p *printer) collection(l *syntax.ExprList, empty string) {
+	if len(l.List) == 0 && !hasComment(l) {
+		p.write(strings.TrimSuffix(empty, "]"))
+		p.closing(l)
+		p.write("]")
+		return
+	}
+	if p.fitsOnOneLine(l) {
+		p.write("[")
+		for i, x := range l.List {
+			if i > 0 {
+				p.write(", ")
+			}
+			p.expr(x)
+		}
+		p.closing(l)
+		p.write("]")
+		return
+	}
+	multi := &syntax.ExprList{List: l.List, Breaks: make([][]*syntax.LineBreak, len(l.List)), Comma: l.Comma, Trailing: l.Trailing, Closing: l.Closing}
+	for i, breaks := range l.Breaks {
+		multi.Breaks[i] = breaks
+		if len(breaks) == 0 {
+			multi.Breaks[i] = []*syntax.LineBreak{{}}
+		}
+	}
+	if len(multi.Trailing) == 0 {
+		multi.Trailing = []*syntax.LineBreak{{}}
+	}
+	if len(l.List) == 0 {
+		// NOTE: `[:` and comments
+		p.exprList(strings.TrimSuffix(empty, "]"), multi, "]")
+		return
+	}
+	p.exprList("[", p.trailingComma(multi), "]")
+}
+
+// fitsOnOneLine reports whether the elements of the literal can be written on the current line
+// NOTE: a line break right after '[' keeps the literal multi-line as written
+func (p *printer) fitsOnOneLine(l *syntax.ExprList) bool {
+	if len(l.List) == 0 || len(l.Breaks[0]) > 0 || hasComment(l) {
+		return false
+	}
+	if p.flat {
+		return true
+	}
+	flat := printer{out: &outputStream{indentSapceNum: p.out.indentSapceNum, indentTab: p.out.indentTab}, opts: p.opts, indent_level: p.indent_level, flat: true}
+	for i, x := range l.List {
+		if i > 0 {
+			flat.write(", ")
+		}
+		flat.expr(x)
+	}
+	text := flat.out.String()
+	if strings.Contains(text, "\n") && len(l.List) > 1 {
+		// NOTE: a single multi-line element e.g. `[a: {` ... `}]` is hugged
+		return false
+	}
+	if i := strings.Index(text, "\n"); i >= 0 {
+		text = text[:i]
+	}
+	return p.out.Width(p.indent_level)+p.out.TextWidth(text)+len("[]") <= p.maxLineWidth()
+}
+
+// hasComment reports whether the line breaks in the list have comments
+func hasComment(l *syntax.ExprList) bool {
+	for _, breaks := range l.Breaks {
+		for _, b := range breaks {
+			if b.Comment != "" {
+				return true
+			}
+		}
+	}
+	for _, b := range l.Trailing {
+		if b.Comment != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// trailingComma applies Options.TrailingComma to list and map literals
+func (p *printer) trailingComma(l *syntax.ExprList) *syntax.ExprList {
+	comma := l.Comma
+	switch p.opts.TrailingComma {
+	case TrailingCommaAlways:
+		// NOTE: only when the closing bracket is on its own line
+		comma = len(l.List) > 0 && len(l.Trailing) > 0
+	case TrailingCommaNever:
+		comma = false
+	}
+	if comma == l.Comma {
+		return l
+	}
+	copied := *l
+	copied.Comma = comma
+	return &copied
+}
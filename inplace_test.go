@@ -0,0 +1,91 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInplace(t *testing.T) {
+	tests := []struct {
+		args   []string
+		backup bool
+	}{
+		{[]string{"-i"}, false},
+		{[]string{"-i", "-backup"}, true},
+	}
+	for _, tt := range tests {
+		dir := writeFiles(t, map[string]string{"Jenkinsfile": unformatted})
+		filename := filepath.Join(dir, "Jenkinsfile")
+		if err := os.Chmod(filename, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if _, code := run(t, dir, append(tt.args, "Jenkinsfile")...); code != 0 {
+			t.Fatalf("%v exits with status %d", tt.args, code)
+		}
+		if got := readFile(t, filename); got != formatted {
+			t.Errorf("%v writes %q, want %q", tt.args, got, formatted)
+		}
+		if info, err := os.Stat(filename); err != nil || info.Mode().Perm() != 0o755 {
+			t.Errorf("%v doesn't keep the file mode: %v %v", tt.args, info.Mode(), err)
+		}
+		// NOTE: the temporary file is renamed to the file
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		want := []string{"Jenkinsfile"}
+		if tt.backup {
+			want = append(want, "Jenkinsfile.orig")
+			if got := readFile(t, filename+".orig"); got != unformatted {
+				t.Errorf("%v backups %q, want %q", tt.args, got, unformatted)
+			}
+		}
+		if strings.Join(names, ",") != strings.Join(want, ",") {
+			t.Errorf("%v leaves %v, want %v", tt.args, names, want)
+		}
+	}
+}
+
+func TestInplaceUnchanged(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"Jenkinsfile": formatted})
+	filename := filepath.Join(dir, "Jenkinsfile")
+	mtime := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	if err := os.Chtimes(filename, mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	if _, code := run(t, dir, "-i", "-backup", "Jenkinsfile"); code != 0 {
+		t.Fatalf("-i exits with status %d", code)
+	}
+	// NOTE: formatted files are not rewritten nor backed up
+	if info, err := os.Stat(filename); err != nil || !info.ModTime().Equal(mtime) {
+		t.Errorf("-i rewrites the formatted file: %v %v", info.ModTime(), err)
+	}
+	if _, err := os.Stat(filename + ".orig"); !os.IsNotExist(err) {
+		t.Errorf("-i backups the formatted file: %v", err)
+	}
+}
+
+func TestInplaceSymlink(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"Jenkinsfile.prod": unformatted})
+	link := filepath.Join(dir, "Jenkinsfile")
+	if err := os.Symlink("Jenkinsfile.prod", link); err != nil {
+		t.Skip(err)
+	}
+	if _, code := run(t, dir, "-i", "Jenkinsfile"); code != 0 {
+		t.Fatalf("-i exits with status %d", code)
+	}
+	// NOTE: the target is rewritten and the link is kept
+	if info, err := os.Lstat(link); err != nil || info.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("-i replaces the symbolic link: %v", err)
+	}
+	if got := readFile(t, filepath.Join(dir, "Jenkinsfile.prod")); got != formatted {
+		t.Errorf("-i writes %q to the target, want %q", got, formatted)
+	}
+}
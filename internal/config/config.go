@@ -0,0 +1,209 @@
+// Package config loads the project configuration file (.goenkins-format.yaml or .goenkins-format.toml).
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/umaumax/goenkins-format/format"
+)
+
+// FileNames is the names of config files in order of priority
+var FileNames = []string{
+	".goenkins-format.yaml",
+	".goenkins-format.yml",
+	".goenkins-format.toml",
+}
+
+// Config is the settings of a config file
+// nil or empty fields are not specified
+type Config struct {
+	// Path is the config file path
+	Path string
+
+	IndentWidth              *int
+	IndentStyle              string
+	QuoteStyle               string
+	TrailingComma            string
+	MaxBlankLines            *int
+	MaxLineWidth             *int
+	BlankLineBetweenSections *bool
+	// Exclude is .gitignore style patterns relative to the config file directory
+	Exclude []string
+}
+
+// Dir returns the directory of the config file
+func (c *Config) Dir() string {
+	return filepath.Dir(c.Path)
+}
+
+// Apply overwrites opts by the specified settings
+func (c *Config) Apply(opts *format.Options) {
+	if c.IndentWidth != nil {
+		opts.IndentSpaceNum = *c.IndentWidth
+	}
+	if c.IndentStyle != "" {
+		opts.IndentStyle = c.IndentStyle
+	}
+	if c.QuoteStyle != "" {
+		opts.QuoteStyle = c.QuoteStyle
+	}
+	if c.TrailingComma != "" {
+		opts.TrailingComma = c.TrailingComma
+	}
+	if c.MaxBlankLines != nil {
+		opts.MaxBlankLines = *c.MaxBlankLines
+		if *c.MaxBlankLines == 0 {
+			opts.MaxBlankLines = format.NoBlankLines
+		}
+	}
+	if c.MaxLineWidth != nil {
+		opts.MaxLineWidth = *c.MaxLineWidth
+	}
+	if c.BlankLineBetweenSections != nil {
+		opts.BlankLineBetweenSections = *c.BlankLineBetweenSections
+	}
+}
+
+// Find returns the config file path in dir or the nearest parent directory, or "" if not found
+func Find(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		for _, name := range FileNames {
+			path := filepath.Join(dir, name)
+			if info, err := os.Stat(path); err == nil && !info.IsDir() {
+				return path, nil
+			}
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// Load parses the config file
+func Load(path string) (*Config, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var vs values
+	if filepath.Ext(path) == ".toml" {
+		vs, err = parseTOML(string(src))
+	} else {
+		vs, err = parseYAML(string(src))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	c := &Config{Path: path}
+	if err := c.decode(vs); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return c, nil
+}
+
+func (c *Config) decode(vs values) error {
+	for key, v := range vs {
+		switch key {
+		case "indent_width":
+			n, err := intValue(key, v)
+			if err != nil {
+				return err
+			}
+			c.IndentWidth = &n
+		case "indent_style":
+			s, err := stringValue(key, v)
+			if err != nil {
+				return err
+			}
+			c.IndentStyle = s
+		case "quote_style":
+			s, err := stringValue(key, v)
+			if err != nil {
+				return err
+			}
+			c.QuoteStyle = s
+		case "trailing_comma":
+			s, err := stringValue(key, v)
+			if err != nil {
+				return err
+			}
+			c.TrailingComma = s
+		case "max_blank_lines":
+			n, err := intValue(key, v)
+			if err != nil {
+				return err
+			}
+			if n < 0 {
+				return fmt.Errorf("%s must not be negative", key)
+			}
+			c.MaxBlankLines = &n
+		case "max_line_width":
+			n, err := intValue(key, v)
+			if err != nil {
+				return err
+			}
+			c.MaxLineWidth = &n
+		case "blank_line_between_sections":
+			b, err := boolValue(key, v)
+			if err != nil {
+				return err
+			}
+			c.BlankLineBetweenSections = &b
+		case "exclude":
+			list, ok := v.([]string)
+			if !ok {
+				list = []string{v.(string)}
+			}
+			c.Exclude = list
+		default:
+			return fmt.Errorf("unknown key %q", key)
+		}
+	}
+	if c.IndentWidth != nil && *c.IndentWidth < 0 {
+		return fmt.Errorf("indent_width must not be negative")
+	}
+	opts := format.Options{}
+	c.Apply(&opts)
+	return opts.Validate()
+}
+
+func stringValue(key string, v interface{}) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string", key)
+	}
+	return s, nil
+}
+
+func boolValue(key string, v interface{}) (bool, error) {
+	s, ok := v.(string)
+	if !ok {
+		return false, fmt.Errorf("%s must be a boolean", key)
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, fmt.Errorf("%s must be a boolean", key)
+	}
+	return b, nil
+}
+
+func intValue(key string, v interface{}) (int, error) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, fmt.Errorf("%s must be an integer", key)
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer", key)
+	}
+	return n, nil
+}
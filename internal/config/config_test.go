@@ -0,0 +1,73 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/umaumax/goenkins-format/format"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		want    format.Options
+		wantErr bool
+	}{
+		{".goenkins-format.yaml", "indent_width: 4\nindent_style: tab\nmax_line_width: 80\n", format.Options{IndentSpaceNum: 4, IndentStyle: format.IndentTab, MaxLineWidth: 80}, false},
+		{".goenkins-format.toml", "quote_style = \"single\"\nblank_line_between_sections = true\n", format.Options{QuoteStyle: format.QuoteSingle, BlankLineBetweenSections: true}, false},
+		{".goenkins-format.yaml", "max_blank_lines: 0\n", format.Options{MaxBlankLines: format.NoBlankLines}, false},
+		{".goenkins-format.yaml", "max_blank_lines: 2\n", format.Options{MaxBlankLines: 2}, false},
+		{".goenkins-format.yaml", "max_blank_lines: -1\n", format.Options{}, true},
+		{".goenkins-format.yaml", "indent_width: x\n", format.Options{}, true},
+		{".goenkins-format.yaml", "quote_style: backquote\n", format.Options{}, true},
+		{".goenkins-format.yaml", "unknown: 1\n", format.Options{}, true},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), tt.name)
+		if err := os.WriteFile(path, []byte(tt.src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		c, err := Load(path)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Load(%q) error = %v, want error %v", tt.src, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		var got format.Options
+		c.Apply(&got)
+		if got != tt.want {
+			t.Errorf("Load(%q) applies %+v, want %+v", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	root := t.TempDir()
+	sub := filepath.Join(root, "a", "b")
+	if err := os.MkdirAll(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{".goenkins-format.toml", ".goenkins-format.yaml", filepath.Join("a", ".goenkins-format.toml")} {
+		if err := os.WriteFile(filepath.Join(root, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		dir  string
+		want string
+	}{
+		// NOTE: yaml is preferred to toml in the same directory
+		{root, filepath.Join(root, ".goenkins-format.yaml")},
+		{sub, filepath.Join(root, "a", ".goenkins-format.toml")},
+	}
+	for _, tt := range tests {
+		got, err := Find(tt.dir)
+		if err != nil || got != tt.want {
+			t.Errorf("Find(%q) = %q, %v, want %q", tt.dir, got, err, tt.want)
+		}
+	}
+}
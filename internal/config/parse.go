@@ -0,0 +1,164 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NOTE: parsers of the flat subset of YAML and TOML used by the config file
+// values are string or []string
+
+type values map[string]interface{}
+
+// parseYAML parses `key: value` lines, flow sequences `key: [a, b]` and block sequences `- item`
+func parseYAML(src string) (values, error) {
+	vs := values{}
+	var listKey string
+	for i, line := range strings.Split(src, "\n") {
+		line = strings.TrimRight(stripComment(line), " \t\r")
+		if strings.TrimSpace(line) == "" || line == "---" {
+			continue
+		}
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "- ") || trimmed == "-" {
+			if listKey == "" {
+				return nil, fmt.Errorf("line %d: unexpected list item", i+1)
+			}
+			item, err := unquote(strings.TrimSpace(strings.TrimPrefix(trimmed, "-")))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", i+1, err)
+			}
+			vs[listKey] = append(vs[listKey].([]string), item)
+			continue
+		}
+		if line != trimmed {
+			return nil, fmt.Errorf("line %d: nested mappings are not supported", i+1)
+		}
+		colon := strings.Index(line, ":")
+		if colon < 0 {
+			return nil, fmt.Errorf("line %d: expected `key: value`", i+1)
+		}
+		key := strings.TrimSpace(line[:colon])
+		value := strings.TrimSpace(line[colon+1:])
+		listKey = ""
+		if value == "" {
+			// NOTE: block sequence follows
+			listKey = key
+			vs[key] = []string{}
+			continue
+		}
+		v, err := parseValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", i+1, err)
+		}
+		vs[key] = v
+	}
+	return vs, nil
+}
+
+// parseTOML parses `key = value` lines and arrays `key = ["a", "b"]` which may span lines
+func parseTOML(src string) (values, error) {
+	vs := values{}
+	lines := strings.Split(src, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(stripComment(lines[i]))
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			return nil, fmt.Errorf("line %d: tables are not supported", i+1)
+		}
+		eq := strings.Index(line, "=")
+		if eq < 0 {
+			return nil, fmt.Errorf("line %d: expected `key = value`", i+1)
+		}
+		key := strings.TrimSpace(line[:eq])
+		value := strings.TrimSpace(line[eq+1:])
+		start := i
+		for strings.HasPrefix(value, "[") && !strings.HasSuffix(value, "]") && i+1 < len(lines) {
+			i++
+			value += " " + strings.TrimSpace(stripComment(lines[i]))
+		}
+		v, err := parseValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", start+1, err)
+		}
+		vs[key] = v
+	}
+	return vs, nil
+}
+
+func parseValue(value string) (interface{}, error) {
+	if !strings.HasPrefix(value, "[") {
+		return unquote(value)
+	}
+	if !strings.HasSuffix(value, "]") {
+		return nil, fmt.Errorf("unterminated list %s", value)
+	}
+	list := []string{}
+	for _, item := range splitItems(value[1 : len(value)-1]) {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		v, err := unquote(item)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+// splitItems splits by commas outside of quotes
+func splitItems(s string) []string {
+	var items []string
+	var quote rune
+	start := 0
+	for i, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == ',':
+			items = append(items, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(items, s[start:])
+}
+
+func unquote(s string) (string, error) {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return strconv.Unquote(s)
+	}
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+	}
+	if strings.ContainsAny(s, `"'`) {
+		return "", fmt.Errorf("invalid quoted value %s", s)
+	}
+	return s, nil
+}
+
+// stripComment removes `# comment` outside of quotes
+func stripComment(line string) string {
+	var quote rune
+	for i, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
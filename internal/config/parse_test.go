@@ -0,0 +1,82 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseYAML(t *testing.T) {
+	tests := []struct {
+		src     string
+		want    values
+		wantErr bool
+	}{
+		{"", values{}, false},
+		{"---\n# comment\nindent_width: 4 # spaces\n", values{"indent_width": "4"}, false},
+		{"quote_style: 'single'\nindent_style: \"tab\"\n", values{"quote_style": "single", "indent_style": "tab"}, false},
+		{"exclude: [a/, 'b, c', \"#d\"]\n", values{"exclude": []string{"a/", "b, c", "#d"}}, false},
+		{"exclude: []\n", values{"exclude": []string{}}, false},
+		{"exclude:\n  - a/\n  - 'it''s'\nindent_width: 2\n", values{"exclude": []string{"a/", "it's"}, "indent_width": "2"}, false},
+		{"exclude:\n", values{"exclude": []string{}}, false},
+		{"- a\n", nil, true},
+		{"indent_width\n", nil, true},
+		{"format:\n  indent_width: 2\n", nil, true},
+		{"exclude: [a, b\n", nil, true},
+		{"quote_style: 'single\n", nil, true},
+	}
+	for _, tt := range tests {
+		got, err := parseYAML(tt.src)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseYAML(%q) error = %v, want error %v", tt.src, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseYAML(%q) = %#v, want %#v", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestParseTOML(t *testing.T) {
+	tests := []struct {
+		src     string
+		want    values
+		wantErr bool
+	}{
+		{"", values{}, false},
+		{"# comment\nindent_width = 4 # spaces\n", values{"indent_width": "4"}, false},
+		{"quote_style = \"single\"\nblank_line_between_sections = true\n", values{"quote_style": "single", "blank_line_between_sections": "true"}, false},
+		{"exclude = [\"a/\", 'b']\n", values{"exclude": []string{"a/", "b"}}, false},
+		{"exclude = [\n  \"a/\", # comment\n  \"b\",\n]\n", values{"exclude": []string{"a/", "b"}}, false},
+		{"[format]\nindent_width = 2\n", nil, true},
+		{"indent_width\n", nil, true},
+		{"exclude = [\"a\"\n", nil, true},
+	}
+	for _, tt := range tests {
+		got, err := parseTOML(tt.src)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseTOML(%q) error = %v, want error %v", tt.src, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseTOML(%q) = %#v, want %#v", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestStripComment(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{"a: 1 # comment", "a: 1 "},
+		{"# comment", ""},
+		{"a: b#c", "a: b#c"},
+		{"a: '# not comment' # comment", "a: '# not comment' "},
+		{`a: "x # y"`, `a: "x # y"`},
+	}
+	for _, tt := range tests {
+		if got := stripComment(tt.line); got != tt.want {
+			t.Errorf("stripComment(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+}
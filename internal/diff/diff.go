@@ -0,0 +1,208 @@
+// Package diff generates line based unified diffs.
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const contextLineNum = 3
+
+type edit struct {
+	kind byte // NOTE: ' ', '-' or '+'
+	line string
+}
+
+// Unified returns the unified diff of old and new, or nil if they are equal
+func Unified(oldName, newName string, old, new []byte) []byte {
+	if bytes.Equal(old, new) {
+		return nil
+	}
+	edits := diffLines(splitLines(old), splitLines(new))
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "--- %s\n", oldName)
+	fmt.Fprintf(&out, "+++ %s\n", newName)
+	for _, h := range hunks(edits) {
+		writeHunk(&out, edits, h)
+	}
+	return out.Bytes()
+}
+
+// splitLines splits b into lines, keeping the trailing "\n" of each line
+func splitLines(b []byte) []string {
+	var lines []string
+	for len(b) > 0 {
+		i := bytes.IndexByte(b, '\n')
+		if i < 0 {
+			i = len(b) - 1
+		}
+		lines = append(lines, string(b[:i+1]))
+		b = b[i+1:]
+	}
+	return lines
+}
+
+// diffLines computes the shortest edit script by the linear space variant of Myers' O(ND) algorithm
+// NOTE: the middle snake of the edit graph divides it into two smaller graphs
+// so that the memory is O(N+M) instead of keeping the furthest points of every D
+func diffLines(a, b []string) []edit {
+	n := len(a) + len(b) + 4
+	d := &differ{a: a, b: b, vf: make([]int, n), vb: make([]int, n), edits: make([]edit, 0, len(a)+len(b))}
+	d.compare(0, len(a), 0, len(b))
+	return d.edits
+}
+
+type differ struct {
+	a, b   []string
+	vf, vb []int // NOTE: the furthest x of the forward and backward paths on each diagonal
+	edits  []edit
+}
+
+// compare appends the edits from a[a0:a1] to b[b0:b1]
+func (d *differ) compare(a0, a1, b0, b1 int) {
+	for a0 < a1 && b0 < b1 && d.a[a0] == d.b[b0] {
+		d.edits = append(d.edits, edit{' ', d.a[a0]})
+		a0++
+		b0++
+	}
+	suffix := 0
+	for a0 < a1-suffix && b0 < b1-suffix && d.a[a1-suffix-1] == d.b[b1-suffix-1] {
+		suffix++
+	}
+	a1, b1 = a1-suffix, b1-suffix
+	switch {
+	case a0 == a1:
+		for _, line := range d.b[b0:b1] {
+			d.edits = append(d.edits, edit{'+', line})
+		}
+	case b0 == b1:
+		for _, line := range d.a[a0:a1] {
+			d.edits = append(d.edits, edit{'-', line})
+		}
+	default:
+		x, y, u, v := d.middleSnake(a0, a1, b0, b1)
+		d.compare(a0, a0+x, b0, b0+y)
+		for _, line := range d.a[a0+x : a0+u] {
+			d.edits = append(d.edits, edit{' ', line})
+		}
+		d.compare(a0+u, a1, b0+v, b1)
+	}
+	for _, line := range d.a[a1 : a1+suffix] {
+		d.edits = append(d.edits, edit{' ', line})
+	}
+}
+
+// middleSnake returns the start (x, y) and the end (u, v) relative to (a0, b0) of the snake
+// in the middle of the shortest edit script by searching from both ends
+func (d *differ) middleSnake(a0, a1, b0, b1 int) (x, y, u, v int) {
+	a, b := d.a[a0:a1], d.b[b0:b1]
+	n, m := len(a), len(b)
+	delta := n - m
+	odd := delta%2 != 0
+	max := (n + m + 1) / 2
+	offset := max + 1
+	vf, vb := d.vf[:2*max+3], d.vb[:2*max+3]
+	vf[offset+1], vb[offset+1] = 0, 0
+	for D := 0; D <= max; D++ {
+		for k := -D; k <= D; k += 2 {
+			if k == -D || k != D && vf[offset+k-1] < vf[offset+k+1] {
+				x = vf[offset+k+1]
+			} else {
+				x = vf[offset+k-1] + 1
+			}
+			y = x - k
+			u, v = x, y
+			for u < n && v < m && a[u] == b[v] {
+				u++
+				v++
+			}
+			vf[offset+k] = u
+			// NOTE: the backward path on the diagonal delta-k has been searched D-1 times
+			if c := delta - k; odd && -(D-1) <= c && c <= D-1 && u+vb[offset+c] >= n {
+				return x, y, u, v
+			}
+		}
+		for k := -D; k <= D; k += 2 {
+			// NOTE: x and y of the backward path are counted from the ends
+			if k == -D || k != D && vb[offset+k-1] < vb[offset+k+1] {
+				x = vb[offset+k+1]
+			} else {
+				x = vb[offset+k-1] + 1
+			}
+			y = x - k
+			u, v = x, y
+			for u < n && v < m && a[n-u-1] == b[m-v-1] {
+				u++
+				v++
+			}
+			vb[offset+k] = u
+			if c := delta - k; !odd && -D <= c && c <= D && u+vf[offset+c] >= n {
+				return n - u, m - v, n - x, m - y
+			}
+		}
+	}
+	panic("diff: no middle snake")
+}
+
+// hunks returns [start, end) ranges of edits including context lines
+func hunks(edits []edit) [][2]int {
+	var ranges [][2]int
+	for i, e := range edits {
+		if e.kind == ' ' {
+			continue
+		}
+		start := i - contextLineNum
+		if start < 0 {
+			start = 0
+		}
+		end := i + 1 + contextLineNum
+		if end > len(edits) {
+			end = len(edits)
+		}
+		if len(ranges) > 0 && start <= ranges[len(ranges)-1][1] {
+			ranges[len(ranges)-1][1] = end
+			continue
+		}
+		ranges = append(ranges, [2]int{start, end})
+	}
+	return ranges
+}
+
+func writeHunk(out *bytes.Buffer, edits []edit, h [2]int) {
+	// NOTE: line numbers of the first line of the hunk
+	oldLine, newLine := 1, 1
+	for _, e := range edits[:h[0]] {
+		if e.kind != '+' {
+			oldLine++
+		}
+		if e.kind != '-' {
+			newLine++
+		}
+	}
+	oldNum, newNum := 0, 0
+	for _, e := range edits[h[0]:h[1]] {
+		if e.kind != '+' {
+			oldNum++
+		}
+		if e.kind != '-' {
+			newNum++
+		}
+	}
+	// NOTE: an empty range starts at the line before it
+	if oldNum == 0 {
+		oldLine--
+	}
+	if newNum == 0 {
+		newLine--
+	}
+	fmt.Fprintf(out, "@@ -%d,%d +%d,%d @@\n", oldLine, oldNum, newLine, newNum)
+	for _, e := range edits[h[0]:h[1]] {
+		out.WriteByte(e.kind)
+		out.WriteString(e.line)
+		if !strings.HasSuffix(e.line, "\n") {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
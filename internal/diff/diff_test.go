@@ -0,0 +1,109 @@
+package diff
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want string // NOTE: without the file headers
+	}{
+		{"equal", "a\nb\n", "a\nb\n", ""},
+		{"change", "a\nb\nc\n", "a\nB\nc\n", "@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n"},
+		{"insert at the beginning", "a\nb\n", "x\na\nb\n", "@@ -1,2 +1,3 @@\n+x\n a\n b\n"},
+		{"delete at the end", "a\nb\nc\n", "a\nb\n", "@@ -1,3 +1,2 @@\n a\n b\n-c\n"},
+		{"empty old", "", "a\n", "@@ -0,0 +1,1 @@\n+a\n"},
+		{"empty new", "a\n", "", "@@ -1,1 +0,0 @@\n-a\n"},
+		{"no newline at end of file", "a\nb", "a\nb\n", "@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n"},
+		{
+			"merged hunks",
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n",
+			"1\n2\nthree\n4\n5\n6\n7\neight\n9\n10\n",
+			"@@ -1,10 +1,10 @@\n 1\n 2\n-3\n+three\n 4\n 5\n 6\n 7\n-8\n+eight\n 9\n 10\n",
+		},
+		{
+			"separate hunks",
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			"one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\ntwelve\n",
+			"@@ -1,4 +1,4 @@\n-1\n+one\n 2\n 3\n 4\n@@ -9,4 +9,4 @@\n 9\n 10\n 11\n-12\n+twelve\n",
+		},
+	}
+	for _, tt := range tests {
+		got := string(Unified("a.orig", "a", []byte(tt.old), []byte(tt.new)))
+		if tt.want == "" {
+			if got != "" {
+				t.Errorf("%s: got %q, want no diff", tt.name, got)
+			}
+			continue
+		}
+		header := "--- a.orig\n+++ a\n"
+		if !strings.HasPrefix(got, header) {
+			t.Errorf("%s: got %q, want the header %q", tt.name, got, header)
+			continue
+		}
+		if got = strings.TrimPrefix(got, header); got != tt.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		a, b    []string
+		changes int // NOTE: the number of deletions and insertions of the shortest edit script
+	}{
+		{nil, nil, 0},
+		{[]string{"a"}, []string{"a"}, 0},
+		{[]string{"a"}, []string{"b"}, 2},
+		// NOTE: the example of Myers' paper
+		{[]string{"a", "b", "c", "a", "b", "b", "a"}, []string{"c", "b", "a", "b", "a", "c"}, 5},
+	}
+	for _, tt := range tests {
+		var old, new []string
+		changes := 0
+		for _, e := range diffLines(tt.a, tt.b) {
+			if e.kind != '+' {
+				old = append(old, e.line)
+			}
+			if e.kind != '-' {
+				new = append(new, e.line)
+			}
+			if e.kind != ' ' {
+				changes++
+			}
+		}
+		if strings.Join(old, ",") != strings.Join(tt.a, ",") || strings.Join(new, ",") != strings.Join(tt.b, ",") {
+			t.Errorf("diffLines(%q, %q) edits %q into %q", tt.a, tt.b, old, new)
+		}
+		if changes != tt.changes {
+			t.Errorf("diffLines(%q, %q) has %d changes, want %d", tt.a, tt.b, changes, tt.changes)
+		}
+	}
+}
+
+// NOTE: re-indenting every line is the common case of the first run e.g. -d of a large file
+func TestDiffLinesMemory(t *testing.T) {
+	const lineNum = 5000
+	a, b := make([]string, lineNum), make([]string, lineNum)
+	for i := range a {
+		a[i] = fmt.Sprintf("line %d\n", i)
+		b[i] = "  " + a[i]
+	}
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	edits := diffLines(a, b)
+	runtime.ReadMemStats(&after)
+	if len(edits) != 2*lineNum {
+		t.Errorf("diffLines() has %d edits, want %d", len(edits), 2*lineNum)
+	}
+	// NOTE: O(N+M) memory, keeping the furthest points of every D needs about 1.6GB
+	if alloc := after.TotalAlloc - before.TotalAlloc; alloc > 4<<20 {
+		t.Errorf("diffLines() allocates %d bytes", alloc)
+	}
+}
@@ -0,0 +1,154 @@
+// Package editorconfig reads the properties of a file from .editorconfig files.
+package editorconfig
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/umaumax/goenkins-format/format"
+)
+
+// FileName is the name of EditorConfig files
+const FileName = ".editorconfig"
+
+// Properties is the lower case properties of a file
+type Properties map[string]string
+
+// section is the properties of a glob pattern
+type section struct {
+	glob       glob
+	properties Properties
+}
+
+type file struct {
+	root     bool
+	sections []section
+}
+
+// Find returns the properties of path from .editorconfig files in the directory of path and its parents
+// The nearer files and the later sections take precedence
+func Find(path string) (Properties, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	var files []*file
+	for dir := filepath.Dir(path); ; {
+		f, err := load(filepath.Join(dir, FileName), dir)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if f != nil {
+			files = append(files, f)
+			if f.root {
+				break
+			}
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+	props := Properties{}
+	name := filepath.ToSlash(path)
+	for i := len(files) - 1; i >= 0; i-- {
+		for _, s := range files[i].sections {
+			if !s.glob.match(name) {
+				continue
+			}
+			for key, value := range s.properties {
+				props[key] = value
+			}
+		}
+	}
+	return props, nil
+}
+
+func load(filename string, dir string) (*file, error) {
+	fp, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+	f := &file{}
+	var current *section
+	scanner := bufio.NewScanner(fp)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			f.sections = append(f.sections, section{
+				glob:       compileGlob(filepath.ToSlash(dir), line[1:len(line)-1]),
+				properties: Properties{},
+			})
+			current = &f.sections[len(f.sections)-1]
+			continue
+		}
+		eq := strings.IndexAny(line, "=:")
+		if eq < 0 {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(line[:eq]))
+		value := strings.TrimSpace(line[eq+1:])
+		if current == nil {
+			// NOTE: only `root` is allowed in the preamble
+			if key == "root" {
+				f.root = strings.ToLower(value) == "true"
+			}
+			continue
+		}
+		current.properties[key] = strings.ToLower(value)
+	}
+	return f, scanner.Err()
+}
+
+// WithoutIndent returns the properties except indent_style, indent_size and tab_width
+func (props Properties) WithoutIndent() Properties {
+	rest := Properties{}
+	for key, value := range props {
+		switch key {
+		case "indent_style", "indent_size", "tab_width":
+		default:
+			rest[key] = value
+		}
+	}
+	return rest
+}
+
+// Apply overwrites opts by the supported properties
+// unset and invalid values are ignored
+func (props Properties) Apply(opts *format.Options) {
+	switch props["indent_style"] {
+	case "space":
+		opts.IndentStyle = format.IndentSpace
+	case "tab":
+		opts.IndentStyle = format.IndentTab
+	}
+	size := props["indent_size"]
+	if size == "tab" {
+		size = props["tab_width"]
+	}
+	if n, err := strconv.Atoi(size); err == nil && n > 0 {
+		opts.IndentSpaceNum = n
+	}
+	switch props["end_of_line"] {
+	case "lf":
+		opts.EndOfLine = format.EndOfLineLF
+	case "crlf":
+		opts.EndOfLine = format.EndOfLineCRLF
+	case "cr":
+		opts.EndOfLine = format.EndOfLineCR
+	}
+	switch props["insert_final_newline"] {
+	case "true":
+		opts.FinalNewline = format.FinalNewlineInsert
+	case "false":
+		opts.FinalNewline = format.FinalNewlineRemove
+	}
+}
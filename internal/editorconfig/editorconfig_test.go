@@ -0,0 +1,72 @@
+package editorconfig
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/umaumax/goenkins-format/format"
+)
+
+func TestFind(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		".editorconfig":     "root = true\n[*]\nindent_style = space\nindent_size = 4\n[Jenkinsfile*]\nEnd_Of_Line = CRLF\n",
+		"a/.editorconfig":   "# comment\n[*]\nindent_size = 2\n; comment\n[vars/*.groovy]\nindent_style = tab\n",
+		"a/Jenkinsfile":     "",
+		"a/vars/x.groovy":   "",
+		"b/.editorconfig":   "root = true\n[*.groovy]\ninsert_final_newline = true\n",
+		"b/Jenkinsfile":     "",
+		"b/c/Jenkinsfile.x": "",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		path string
+		want Properties
+	}{
+		{"a/Jenkinsfile", Properties{"indent_style": "space", "indent_size": "2", "end_of_line": "crlf"}},
+		{"a/vars/x.groovy", Properties{"indent_style": "tab", "indent_size": "2"}},
+		// NOTE: root = true stops at b/.editorconfig
+		{"b/Jenkinsfile", Properties{}},
+		{"b/c/Jenkinsfile.x", Properties{}},
+	}
+	for _, tt := range tests {
+		got, err := Find(filepath.Join(root, filepath.FromSlash(tt.path)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Find(%q) = %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		props Properties
+		want  format.Options
+	}{
+		{Properties{}, format.Options{}},
+		{Properties{"indent_style": "tab", "indent_size": "tab", "tab_width": "8"}, format.Options{IndentStyle: format.IndentTab, IndentSpaceNum: 8}},
+		{Properties{"indent_style": "space", "indent_size": "0"}, format.Options{IndentStyle: format.IndentSpace}},
+		{Properties{"indent_size": "x", "end_of_line": "cr"}, format.Options{EndOfLine: format.EndOfLineCR}},
+		{Properties{"insert_final_newline": "false"}, format.Options{FinalNewline: format.FinalNewlineRemove}},
+		{Properties{"indent_style": "tab", "indent_size": "4", "end_of_line": "lf"}.WithoutIndent(), format.Options{EndOfLine: format.EndOfLineLF}},
+	}
+	for _, tt := range tests {
+		var got format.Options
+		tt.props.Apply(&got)
+		if got != tt.want {
+			t.Errorf("%v Apply() = %+v, want %+v", tt.props, got, tt.want)
+		}
+	}
+}
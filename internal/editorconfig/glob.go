@@ -0,0 +1,153 @@
+package editorconfig
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// glob is a section name of EditorConfig compiled into a regular expression
+type glob struct {
+	re *regexp.Regexp
+	// NOTE: {num1..num2} ranges in order of the capture groups
+	ranges [][2]int
+}
+
+var numRangeRe = regexp.MustCompile(`^([+-]?\d+)\.\.([+-]?\d+)$`)
+
+// compileGlob compiles pattern relative to dir (slash separated absolute path)
+// A pattern without '/' matches the base name at any level below dir
+func compileGlob(dir string, pattern string) glob {
+	var g glob
+	prefix := regexp.QuoteMeta(strings.TrimSuffix(dir, "/")) + "/"
+	if !strings.Contains(pattern, "/") {
+		prefix += "(?:.*/)?"
+	}
+	pattern = strings.TrimPrefix(pattern, "/")
+	re, err := regexp.Compile("^" + prefix + g.convert(pattern) + "$")
+	if err != nil {
+		// NOTE: never matches
+		re = regexp.MustCompile(`$^`)
+	}
+	g.re = re
+	return g
+}
+
+// convert converts the glob pattern into a regular expression
+func (g *glob) convert(pattern string) string {
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch c {
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+				b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+			} else {
+				b.WriteString(`\\`)
+			}
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				b.WriteString(`.*`)
+			} else {
+				b.WriteString(`[^/]*`)
+			}
+		case '?':
+			b.WriteString(`[^/]`)
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 || strings.Contains(pattern[i+1:i+1+end], "/") {
+				b.WriteString(`\[`)
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		case '{':
+			end := matchingBrace(pattern, i)
+			if end < 0 {
+				b.WriteString(`\{`)
+				continue
+			}
+			inner := pattern[i+1 : end]
+			if m := numRangeRe.FindStringSubmatch(inner); m != nil {
+				from, _ := strconv.Atoi(m[1])
+				to, _ := strconv.Atoi(m[2])
+				g.ranges = append(g.ranges, [2]int{from, to})
+				b.WriteString(`([+-]?\d+)`)
+			} else if alts := splitAlternatives(inner); len(alts) > 1 {
+				for j, alt := range alts {
+					alts[j] = g.convert(alt)
+				}
+				b.WriteString("(?:" + strings.Join(alts, "|") + ")")
+			} else {
+				// NOTE: {single} is not an alternation
+				b.WriteString(regexp.QuoteMeta("{") + g.convert(inner) + regexp.QuoteMeta("}"))
+			}
+			i = end
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return b.String()
+}
+
+// matchingBrace returns the index of '}' closing '{' at start or -1
+func matchingBrace(pattern string, start int) int {
+	depth := 0
+	for i := start; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitAlternatives splits by commas outside of nested braces
+func splitAlternatives(s string) []string {
+	var alts []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				alts = append(alts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(alts, s[start:])
+}
+
+func (g glob) match(name string) bool {
+	m := g.re.FindStringSubmatch(name)
+	if m == nil {
+		return false
+	}
+	for i, r := range g.ranges {
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil || n < r[0] || n > r[1] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+package editorconfig
+
+import "testing"
+
+func TestGlobMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"*", "/p/Jenkinsfile", true},
+		{"*", "/p/a/b/Jenkinsfile", true},
+		{"*", "/other/Jenkinsfile", false},
+		{"*.groovy", "/p/vars/a.groovy", true},
+		{"*.groovy", "/p/a.groovy.orig", false},
+		{"Jenkinsfile*", "/p/ci/Jenkinsfile.prod", true},
+		{"vars/*.groovy", "/p/vars/a.groovy", true},
+		{"vars/*.groovy", "/p/lib/vars/a.groovy", false},
+		{"vars/*.groovy", "/p/vars/sub/a.groovy", false},
+		{"/vars/**.groovy", "/p/vars/sub/a.groovy", true},
+		{"**/Jenkinsfile", "/p/a/b/Jenkinsfile", true},
+		{"a?.groovy", "/p/ab.groovy", true},
+		{"a?.groovy", "/p/a/.groovy", false},
+		{"[ab].groovy", "/p/b.groovy", true},
+		{"[!ab].groovy", "/p/b.groovy", false},
+		{"[!ab].groovy", "/p/c.groovy", true},
+		{"*.{groovy,gvy}", "/p/a.gvy", true},
+		{"*.{groovy,gvy}", "/p/a.java", false},
+		{"{Jenkinsfile,*.{groovy,jenkinsfile}}", "/p/a.jenkinsfile", true},
+		{"{single}", "/p/{single}", true},
+		{"file{1..3}", "/p/file2", true},
+		{"file{1..3}", "/p/file4", false},
+		{"file{-1..1}", "/p/file-1", true},
+		{"\\*.groovy", "/p/*.groovy", true},
+		{"\\*.groovy", "/p/a.groovy", false},
+		{"[a/b].groovy", "/p/[a/b].groovy", true},
+		{"{a,b", "/p/{a,b", true},
+	}
+	for _, tt := range tests {
+		g := compileGlob("/p", tt.pattern)
+		if got := g.match(tt.name); got != tt.want {
+			t.Errorf("%q match(%q) = %v, want %v (%s)", tt.pattern, tt.name, got, tt.want, g.re)
+		}
+	}
+}
@@ -0,0 +1,464 @@
+package syntax
+
+// NOTE: syntax tree built by parser.y and walked by format/printer.go
+
+type Node interface{}
+
+type Stmt interface {
+	Node
+	stmtNode()
+}
+
+type Expr interface {
+	Node
+	exprNode()
+}
+
+// NOTE: statements
+
+// File is a whole input file
+type File struct {
+	Stmts []Stmt
+}
+
+// LineBreak is a line break in the source, optionally preceded by comments on the same line
+// Inline is the inline comments of the line, which are written at the end of line
+// unless the nodes they are attached to are written
+type LineBreak struct {
+	Comment string
+	Inline  []*InlineComment
+}
+
+// InlineComment is a block comment followed by an identifier or a literal on the same line e.g. `agent /* comment */ none`
+type InlineComment struct {
+	Text string
+}
+
+// Semicolon is an explicit ';' statement delimiter
+type Semicolon struct{}
+
+// Block is a '{' ... '}' block or the body of a closure
+type Block struct {
+	Params  *ExprList // NOTE: parameters before `->` of closures or nil
+	Stmts   []Stmt
+	Closing []*InlineComment // NOTE: inline comments before '}' e.g. `{ /* comment */ }`
+}
+
+// PackageStmt is `package a.b`
+type PackageStmt struct {
+	Path string
+}
+
+// ImportStmt is `import a.b.*` or `import static a.b.c`
+type ImportStmt struct {
+	Static bool
+	Path   string
+}
+
+// Annotation is `@Name` or `@Name(args)`
+// Stmt is the annotated statement on the same line e.g. `@Library('x') _`
+type Annotation struct {
+	Name string
+	Args *ExprList // or nil
+	Stmt Stmt      // or nil
+}
+
+// DeclStmt is `def x = value` or `Type x = value`
+type DeclStmt struct {
+	Modifiers string // e.g. "private static final"
+	Type      string // NOTE: "" for `static x = value`
+	Name      string
+	Value     Expr // or nil
+}
+
+// FuncDecl is `def name(params) { ... }` or `Type name(params) { ... }`
+type FuncDecl struct {
+	Modifiers string
+	Type      string // NOTE: "" for constructors
+	Name      string
+	Params    *ExprList
+	Body      *Block // NOTE: nil for abstract methods
+}
+
+// ClassDecl is `class Name extends A implements B { ... }`, interface or enum
+type ClassDecl struct {
+	Modifiers  string
+	Kind       string // "class", "interface" or "enum"
+	Name       string
+	Extends    []string
+	Implements []string
+	Constants  *ExprList // NOTE: constants of enum or nil
+	Body       *Block
+}
+
+// ReturnStmt is `return x`
+type ReturnStmt struct {
+	X Expr // or nil
+}
+
+// ThrowStmt is `throw x`
+type ThrowStmt struct {
+	X Expr
+}
+
+// AssignStmt is `lhs = rhs`
+type AssignStmt struct {
+	Lhs Expr
+	Rhs Expr
+}
+
+// ExprStmt is a standalone expression
+type ExprStmt struct {
+	X Expr
+}
+
+// CommandStmt is a method call without parenthesis e.g. `sh 'make'`, `agent any`, `mail to: 'x', subject: 'y'`
+// or a command chain e.g. `please show the square_root of 100` is `please(show).the(square_root).of(100)`
+type CommandStmt struct {
+	Recv *CommandStmt // NOTE: the preceding command of command chains or nil
+	Name string
+	Fun  Expr      // NOTE: the method with the receiver e.g. `steps.sh` of `steps.sh 'x'` instead of Name or nil
+	Args *ExprList // NOTE: nil for the property at the end of command chains e.g. `c` of `a b c`
+}
+
+// BlockCallStmt is a method call followed by a block e.g. `steps { ... }`, `stage('x') { ... }`
+type BlockCallStmt struct {
+	Fun  Expr
+	Args *ExprList // or nil when there are no parenthesis
+	Body *Block
+}
+
+// IfStmt is `if (cond) { ... } else ...`
+type IfStmt struct {
+	Cond Expr
+	Then Stmt // NOTE: *Block or a statement without braces e.g. `if (x) return`
+	Else Stmt // *Block or *IfStmt or a statement without braces or nil
+}
+
+// ForInStmt is `for (x in xs) { ... }` or `for (String x in xs) { ... }`
+type ForInStmt struct {
+	Type string // NOTE: `def`, the type of the variable or ""
+	Var  string
+	X    Expr
+	Body Stmt
+}
+
+// ForStmt is `for (init; cond; post) { ... }`
+type ForStmt struct {
+	Init Stmt
+	Cond Expr
+	Post Expr
+	Body Stmt
+}
+
+// WhileStmt is `while (cond) { ... }`
+type WhileStmt struct {
+	Cond Expr
+	Body Stmt
+}
+
+// DoWhileStmt is `do { ... } while (cond)`
+type DoWhileStmt struct {
+	Body *Block
+	Cond Expr
+}
+
+// LabeledStmt is a labeled loop e.g. `outer: for (x in xs) { ... }`
+type LabeledStmt struct {
+	Label  string
+	Breaks []*LineBreak
+	Stmt   Stmt
+}
+
+// BranchStmt is `break` or `continue` with an optional label
+type BranchStmt struct {
+	Tok   string
+	Label string
+}
+
+// SwitchStmt is `switch (x) { case a: ... default: ... }`
+type SwitchStmt struct {
+	Tag    Expr
+	Breaks []*LineBreak
+	Cases  []*CaseClause
+}
+
+// CaseClause is `case value:` or `default:` (Value is nil) and the following statements
+type CaseClause struct {
+	Value Expr
+	Body  []Stmt
+}
+
+// TryStmt is `try { ... } catch (Type name) { ... } finally { ... }`
+type TryStmt struct {
+	Body    *Block
+	Catches []*CatchClause
+	Finally *Block // or nil
+}
+
+// CatchClause is `catch (Type name) { ... }`, `catch (A | B name)` or untyped `catch (name)`
+type CatchClause struct {
+	Types []string
+	Name  string
+	Body  *Block
+}
+
+func (*LineBreak) stmtNode()     {}
+func (*Semicolon) stmtNode()     {}
+func (*Block) stmtNode()         {}
+func (*PackageStmt) stmtNode()   {}
+func (*ImportStmt) stmtNode()    {}
+func (*Annotation) stmtNode()    {}
+func (*DeclStmt) stmtNode()      {}
+func (*FuncDecl) stmtNode()      {}
+func (*ClassDecl) stmtNode()     {}
+func (*ReturnStmt) stmtNode()    {}
+func (*ThrowStmt) stmtNode()     {}
+func (*AssignStmt) stmtNode()    {}
+func (*ExprStmt) stmtNode()      {}
+func (*CommandStmt) stmtNode()   {}
+func (*BlockCallStmt) stmtNode() {}
+func (*IfStmt) stmtNode()        {}
+func (*ForInStmt) stmtNode()     {}
+func (*ForStmt) stmtNode()       {}
+func (*WhileStmt) stmtNode()     {}
+func (*DoWhileStmt) stmtNode()   {}
+func (*LabeledStmt) stmtNode()   {}
+func (*BranchStmt) stmtNode()    {}
+func (*SwitchStmt) stmtNode()    {}
+func (*TryStmt) stmtNode()       {}
+
+// NOTE: expressions
+
+// ExprList is a comma separated list of expressions
+// Breaks[i] is the line breaks before List[i] and Trailing is the line breaks before the closing bracket
+type ExprList struct {
+	List     []Expr
+	Breaks   [][]*LineBreak
+	Comma    bool // NOTE: trailing comma
+	Trailing []*LineBreak
+	Closing  []*InlineComment // NOTE: inline comments before the closing bracket
+}
+
+// Ident is an identifier
+type Ident struct {
+	Name     string
+	Comments []*InlineComment // NOTE: leading inline comments
+}
+
+// BasicLit is a literal token (NUMBER, STRING or BOOL)
+type BasicLit struct {
+	Kind     int
+	Value    string
+	Comments []*InlineComment // NOTE: leading inline comments
+}
+
+// ParenExpr is `(x)`
+type ParenExpr struct {
+	X      Expr
+	Lparen []*LineBreak // NOTE: line breaks after '('
+	Rparen []*LineBreak // NOTE: line breaks before ')'
+}
+
+// KeyValue is `key: value`, `'key': value`, `(key): value` or spread `*: map`
+type KeyValue struct {
+	Key   Expr // NOTE: *Ident, *BasicLit, *ParenExpr or nil for spread
+	Value Expr
+}
+
+// NamedArgs is a list of KeyValue e.g. `job: 'x', wait: true`
+type NamedArgs struct {
+	Elems *ExprList
+}
+
+// ListLit is `[a, b]`
+type ListLit struct {
+	Elems *ExprList
+}
+
+// MapLit is `[a: 1, b: 2]`
+type MapLit struct {
+	Elems *ExprList
+}
+
+// CallExpr is `fun(args)`, optionally followed by a closure e.g. `xs.collect { ... }`
+type CallExpr struct {
+	Fun     Expr
+	Args    *ExprList // or nil when there are no parenthesis
+	Closure *Block    // or nil
+}
+
+// SelectorExpr is `x.sel`, `x?.sel` or `x*.sel`
+type SelectorExpr struct {
+	X   Expr
+	Op  string
+	Sel string
+}
+
+// IndexExpr is `x[index]`
+type IndexExpr struct {
+	X     Expr
+	Index *ExprList
+}
+
+// NewExpr is `new Type(args)`
+type NewExpr struct {
+	Type string
+	Args *ExprList
+}
+
+// UnaryExpr is `op x`
+type UnaryExpr struct {
+	Op string
+	X  Expr
+}
+
+// BinaryExpr is `x op y`
+type BinaryExpr struct {
+	X  Expr
+	Op string
+	Y  Expr
+}
+
+// CondExpr is `cond ? then : else`
+type CondExpr struct {
+	Cond Expr
+	Then Expr
+	Else Expr
+}
+
+// Param is a parameter of methods with a type or a default value e.g. `String name = 'x'`
+type Param struct {
+	Type    string // or ""
+	Name    string
+	Default Expr // or nil
+}
+
+// IncDecExpr is `x++` or `x--`
+type IncDecExpr struct {
+	X  Expr
+	Op string
+}
+
+// ClosureExpr is a closure used as a value e.g. `def f = { x -> x * 2 }`, `case { it > 10 }:`
+type ClosureExpr struct {
+	Body *Block
+}
+
+// CommandExpr is a command used as a value e.g. `def m = readJSON text: result`
+type CommandExpr struct {
+	Cmd *CommandStmt
+}
+
+func (*Ident) exprNode()        {}
+func (*BasicLit) exprNode()     {}
+func (*ParenExpr) exprNode()    {}
+func (*KeyValue) exprNode()     {}
+func (*NamedArgs) exprNode()    {}
+func (*ListLit) exprNode()      {}
+func (*MapLit) exprNode()       {}
+func (*CallExpr) exprNode()     {}
+func (*SelectorExpr) exprNode() {}
+func (*IndexExpr) exprNode()    {}
+func (*NewExpr) exprNode()      {}
+func (*UnaryExpr) exprNode()    {}
+func (*BinaryExpr) exprNode()   {}
+func (*CondExpr) exprNode()     {}
+func (*Param) exprNode()        {}
+func (*IncDecExpr) exprNode()   {}
+func (*ClosureExpr) exprNode()  {}
+func (*CommandExpr) exprNode()  {}
+
+// NOTE: helper functions for parser.y actions
+
+func lineBreakStmts(breaks []*LineBreak) []Stmt {
+	stmts := make([]Stmt, 0, len(breaks))
+	for _, b := range breaks {
+		stmts = append(stmts, b)
+	}
+	return stmts
+}
+
+// newCommand treats `name (args)` as a method call
+func newCommand(cmd *CommandStmt) Stmt {
+	if cmd.Recv != nil || cmd.Args == nil || len(cmd.Args.List) != 1 {
+		return cmd
+	}
+	paren, ok := cmd.Args.List[0].(*ParenExpr)
+	if !ok {
+		return cmd
+	}
+	fun := cmd.Fun
+	if fun == nil {
+		fun = &Ident{Name: cmd.Name}
+	}
+	args, ok := paren.X.(*NamedArgs)
+	if !ok {
+		return &ExprStmt{X: &CallExpr{Fun: fun, Args: newExprList(paren.X).enclose(paren.Lparen, paren.Rparen)}}
+	}
+	return &ExprStmt{X: &CallExpr{Fun: fun, Args: args.Elems}}
+}
+
+func newExprList(x Expr) *ExprList {
+	return &ExprList{List: []Expr{x}, Breaks: [][]*LineBreak{nil}}
+}
+
+func (l *ExprList) append(breaks []*LineBreak, x Expr) *ExprList {
+	l.List = append(l.List, x)
+	l.Breaks = append(l.Breaks, breaks)
+	return l
+}
+
+// enclose attaches the line breaks after the opening bracket and before the closing bracket
+func (l *ExprList) enclose(head []*LineBreak, tail []*LineBreak) *ExprList {
+	if len(l.List) > 0 {
+		l.Breaks[0] = append(head, l.Breaks[0]...)
+	} else {
+		l.Trailing = append(l.Trailing, head...)
+	}
+	l.Trailing = append(l.Trailing, tail...)
+	return l
+}
+
+// close attaches inline comments before the closing bracket
+func (l *ExprList) close(comments []*InlineComment) *ExprList {
+	l.Closing = comments
+	return l
+}
+
+// newClosureCall attaches the trailing closure to the method call x
+func newClosureCall(x Expr, closure *Block) *CallExpr {
+	if call, ok := x.(*CallExpr); ok && call.Closure == nil {
+		call.Closure = closure
+		return call
+	}
+	return &CallExpr{Fun: x, Closure: closure}
+}
+
+// newForIn converts `for (x in xs)` with the type of x or "" or returns nil if x is not `ident in expr`
+func newForIn(typ string, x Expr, body Stmt) *ForInStmt {
+	in, ok := x.(*BinaryExpr)
+	if !ok || in.Op != "in" {
+		return nil
+	}
+	v, ok := in.X.(*Ident)
+	if !ok {
+		return nil
+	}
+	return &ForInStmt{Type: typ, Var: v.Name, X: in.Y, Body: body}
+}
+
+func newClassDecl(modifiers, kind, name string, heritage [2][]string, body *Block) *ClassDecl {
+	return &ClassDecl{Modifiers: modifiers, Kind: kind, Name: name, Extends: heritage[0], Implements: heritage[1], Body: body}
+}
+
+// lastIf returns the last if statement of else-if chain
+func (s *IfStmt) lastIf() *IfStmt {
+	for {
+		next, ok := s.Else.(*IfStmt)
+		if !ok {
+			return s
+		}
+		s = next
+	}
+}
@@ -0,0 +1,950 @@
+package syntax
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+)
+
+// NOTE: Lexer is hand written instead of generated by nex
+// because GString interpolations `${ ... }` can contain nested strings and braces
+
+var keywords = map[string]int{
+	"def":         DEF,
+	"new":         NEW,
+	"if":          IF,
+	"else":        ELSE,
+	"sh":          SH,
+	"echo":        ECHO,
+	"import":      IMPORT,
+	"agent":       AGENT,
+	"label":       LABEL,
+	"script":      SCRIPT,
+	"environment": ENVIRONMENT,
+	"stage":       STAGE,
+	"node":        NODE,
+	"dir":         DIR,
+	"any":         ANY,
+	"none":        NONE,
+	"for":         FOR,
+	"in":          IN,
+	"try":         TRY,
+	"catch":       CATCH,
+	"as":          AS,
+	"instanceof":  INSTANCEOF,
+	"package":     PACKAGE,
+	"class":       CLASS,
+	"interface":   INTERFACE,
+	"enum":        ENUM,
+	"extends":     EXTENDS,
+	"implements":  IMPLEMENTS,
+	"return":      RETURN,
+	"throw":       THROW,
+	"switch":      SWITCH,
+	"case":        CASE,
+	"default":     DEFAULT,
+	"while":       WHILE,
+	"do":          DO,
+	"break":       BREAK,
+	"continue":    CONTINUE,
+	"finally":     FINALLY,
+	// NOTE: modifiers
+	"public":       MODIFIER,
+	"protected":    MODIFIER,
+	"private":      MODIFIER,
+	"static":       MODIFIER,
+	"final":        MODIFIER,
+	"abstract":     MODIFIER,
+	"synchronized": MODIFIER,
+	"transient":    MODIFIER,
+	"volatile":     MODIFIER,
+}
+
+// NOTE: words of declarative pipeline which are also used as identifiers e.g. `def label = 'x'`, `[stage: 'x']`
+var softKeywords = map[int]bool{
+	AGENT:       true,
+	LABEL:       true,
+	STAGE:       true,
+	NODE:        true,
+	DIR:         true,
+	SCRIPT:      true,
+	ENVIRONMENT: true,
+	ANY:         true,
+	NONE:        true,
+}
+
+// NOTE: `!in` and `!instanceof` are single tokens unless followed by identifier characters e.g. `!inside`
+var negatedKeywords = map[string]int{
+	"!in":         NOT_IN,
+	"!instanceof": NOT_INSTANCEOF,
+}
+
+// NOTE: the longest operator is matched
+var operators = map[string]int{
+	">>>=": ASSIGN_OP,
+	"===":  IDENTICAL,
+	"!==":  IDENTICAL,
+	"..<":  RANGE,
+	"==~":  MATCH,
+	"<=>":  COMPARE,
+	">>>":  URSHIFT,
+	"**=":  ASSIGN_OP,
+	"<<=":  ASSIGN_OP,
+	">>=":  ASSIGN_OP,
+	"==":   EQ,
+	"!=":   NE,
+	">=":   GE,
+	"<=":   LE,
+	"||":   OR,
+	"&&":   AND,
+	"++":   INCREMENT,
+	"--":   DECREMENT,
+	"->":   ARROW,
+	"=~":   FIND,
+	"**":   POWER,
+	"<<":   LSHIFT,
+	">>":   RSHIFT,
+	"?:":   ELVIS,
+	"?.":   SAFE_DOT,
+	"*.":   SPREAD_DOT,
+	".&":   METHOD_POINTER,
+	".@":   ATTRIBUTE_DOT,
+	"..":   RANGE,
+	"+=":   ASSIGN_OP,
+	"-=":   ASSIGN_OP,
+	"*=":   ASSIGN_OP,
+	"/=":   ASSIGN_OP,
+	"%=":   ASSIGN_OP,
+	"&=":   ASSIGN_OP,
+	"|=":   ASSIGN_OP,
+	"^=":   ASSIGN_OP,
+	"?=":   ASSIGN_OP,
+}
+
+// Lexer splits source code into tokens for yyParse
+type Lexer struct {
+	src []byte
+	pos int
+
+	// NOTE: the current token (line and column start at 0)
+	text         string
+	line, column int
+	// NOTE: position of lastPos to count line and column incrementally
+	lastPos              int
+	lastLine, lastColumn int
+
+	// NOTE: the last token except comments to decide whether '/' starts a slashy string or is a division
+	prev int
+	// NOTE: the tokens before prev to find method declarations e.g. `String foo(`
+	prev2, prev3 int
+
+	// NOTE: bracket depth and '?' or `case` waiting for ':' to distinguish `c ? a : b` and `case x:` from `key: value`
+	depth  int
+	colons []pendingColon
+	// NOTE: open brackets to tell closures `{ ... }` in expressions from blocks of statements
+	brackets []bracket
+	// NOTE: the last closed bracket
+	closed bracket
+	// NOTE: scanning ahead for `->` of closure parameters
+	lookahead bool
+	// NOTE: comments of the lines continued by binary operators, which LexerWrapper moves to the next new line
+	lineComments []string
+
+	// NOTE: the first invalid token e.g. an unterminated string, which is reported instead of the parser error
+	err                string
+	errLine, errColumn int
+}
+
+func NewLexer(src []byte) *Lexer {
+	return &Lexer{src: src, brackets: []bracket{{}}}
+}
+
+// Text returns the text of the current token
+func (yylex *Lexer) Text() string {
+	return yylex.text
+}
+
+// Line returns the line of the current token
+func (yylex *Lexer) Line() int {
+	return yylex.line
+}
+
+// Column returns the column (number of runes) of the current token
+func (yylex *Lexer) Column() int {
+	return yylex.column
+}
+
+func (yylex *Lexer) Lex(lval *yySymType) int {
+	src := yylex.src
+	for yylex.pos < len(src) && strings.IndexByte(" \t\r\f", src[yylex.pos]) >= 0 {
+		yylex.pos++
+	}
+	spaced := yylex.pos > 0 && strings.IndexByte(" \t\r\f", src[yylex.pos-1]) >= 0
+	start := yylex.pos
+	yylex.setPosition(start)
+	if start >= len(src) {
+		yylex.text = ""
+		return 0
+	}
+	token := yylex.scan(lval)
+	if token == NR && continuationTokens[yylex.prev] && yylex.brackets[len(yylex.brackets)-1].expr {
+		// NOTE: a line ending with a binary operator continues on the next line e.g. `a &&\n  b`
+		if lval.str != "" {
+			yylex.lineComments = append(yylex.lineComments, lval.str)
+		}
+		return yylex.Lex(lval)
+	}
+	if token == NR && yylex.clauseFollows() {
+		// NOTE: `else`, `catch` and `finally` on the next line continue the statement e.g. `}\nelse {`
+		if lval.str != "" {
+			yylex.lineComments = append(yylex.lineComments, lval.str)
+		}
+		return yylex.Lex(lval)
+	}
+	if token == '[' && !spaced && yylex.afterOperand() {
+		token = INDEX
+	}
+	if token == '(' && !spaced && yylex.afterOperand() {
+		token = CALL
+	}
+	if (token == '(' || token == CALL) && !yylex.lookahead && yylex.methodDecl() {
+		token = PARAMS
+	}
+	if token == '{' && !yylex.lookahead {
+		token = yylex.brace()
+	}
+	yylex.text = string(src[start:yylex.pos])
+	if token != COMMENT {
+		yylex.prev3, yylex.prev2, yylex.prev = yylex.prev2, yylex.prev, token
+	}
+	token = yylex.colon(token)
+	yylex.bracket(token)
+	return token
+}
+
+func (yylex *Lexer) scan(lval *yySymType) int {
+	src := yylex.src
+	start := yylex.pos
+	c := src[start]
+	switch {
+	case c == '\n':
+		yylex.pos++
+		lval.str = ""
+		return NR
+	case yylex.hasPrefix("//") || start == 0 && yylex.hasPrefix("#!"):
+		// NOTE: a shebang line e.g. `#!/usr/bin/env groovy` is a line comment at the start of file
+		yylex.skipLineComment()
+		// NOTE: return new line value with comment because of including \n at the end
+		lval.str = strings.TrimRight(string(src[start:yylex.pos]), "\r\n")
+		return NR
+	case yylex.hasPrefix("/*"):
+		if !yylex.skipBlockComment() {
+			yylex.setError("comment not terminated")
+			return COMMENT
+		}
+		// NOTE: LexerWrapper attaches multi line comment to the next new line
+		column := utf8.RuneCount(src[bytes.LastIndexByte(src[:start], '\n')+1 : start])
+		lval.str = dedentComment(string(src[start:yylex.pos]), column)
+		return COMMENT
+	case c == '"' || c == '\'':
+		if !yylex.scanString() {
+			yylex.setError("string literal not terminated")
+			return int(c)
+		}
+		lval.str = string(src[start:yylex.pos])
+		return STRING
+	case yylex.hasPrefix("$/"):
+		if !yylex.scanDollarSlashyString() {
+			yylex.setError("dollar slashy string literal not terminated")
+			return int(c)
+		}
+		lval.str = string(src[start:yylex.pos])
+		return STRING
+	case c == '/' && !yylex.afterOperand():
+		if !yylex.scanSlashyString() {
+			yylex.setError("slashy string literal not terminated")
+			return int(c)
+		}
+		lval.str = string(src[start:yylex.pos])
+		return STRING
+	case isDigit(c):
+		yylex.scanNumber()
+		lval.str = string(src[start:yylex.pos])
+		return NUMBER
+	case isIdentChar(c):
+		for yylex.pos < len(src) && isIdentChar(src[yylex.pos]) {
+			yylex.pos++
+		}
+		lval.str = string(src[start:yylex.pos])
+		if token, ok := keywords[lval.str]; ok && yylex.isKeyword(token) {
+			return token
+		}
+		yylex.scanTypeArguments(lval.str)
+		lval.str = string(src[start:yylex.pos])
+		return IDENT
+	}
+	for op, token := range negatedKeywords {
+		end := start + len(op)
+		if yylex.hasPrefix(op) && (end == len(src) || !isIdentChar(src[end])) {
+			yylex.pos = end
+			lval.str = op
+			return token
+		}
+	}
+	for n := 4; n >= 2; n-- {
+		if start+n > len(src) {
+			continue
+		}
+		if token, ok := operators[string(src[start:start+n])]; ok {
+			yylex.pos += n
+			lval.str = string(src[start:yylex.pos])
+			return token
+		}
+	}
+	if strings.IndexByte(";{}=+*%/-<>(.[:,)]!~?&|^@", c) >= 0 {
+		yylex.pos++
+		return int(c)
+	}
+	// NOTE: unknown characters are passed to the parser as is to report syntax errors
+	r, size := utf8.DecodeRune(src[start:])
+	yylex.pos += size
+	return int(r)
+}
+
+// pendingColon is '?' or `case` at the bracket depth
+type pendingColon struct {
+	depth int
+	token int // NOTE: TERNARY_COLON or CASE_COLON
+}
+
+// colon converts ':' of the conditional operator and case labels into TERNARY_COLON and CASE_COLON
+func (yylex *Lexer) colon(token int) int {
+	switch token {
+	case '(', '[', '{', INDEX, CALL, PARAMS, CLOSURE, LAMBDA:
+		yylex.depth++
+	case ')', ']', '}':
+		yylex.depth--
+		// NOTE: drop '?' without ':' in the closed brackets
+		n := len(yylex.colons)
+		for n > 0 && yylex.colons[n-1].depth > yylex.depth {
+			n--
+		}
+		yylex.colons = yylex.colons[:n]
+	case '?':
+		yylex.colons = append(yylex.colons, pendingColon{depth: yylex.depth, token: TERNARY_COLON})
+	case CASE:
+		yylex.colons = append(yylex.colons, pendingColon{depth: yylex.depth, token: CASE_COLON})
+	case ':':
+		if n := len(yylex.colons); n > 0 && yylex.colons[n-1].depth == yylex.depth {
+			colon := yylex.colons[n-1]
+			yylex.colons = yylex.colons[:n-1]
+			return colon.token
+		}
+	}
+	return token
+}
+
+// bracket is an open bracket and whether an expression continues in it
+type bracket struct {
+	token int
+	expr  bool // NOTE: e.g. after `=` or in parenthesis
+	decl  bool // NOTE: in the header of class declarations e.g. `implements A, B {`
+	class bool // NOTE: the body of class declarations
+	// NOTE: the arguments of a method call which can be followed by a closure e.g. `foo(x) {` but not `def foo(x) {`
+	call bool
+}
+
+// NOTE: statements which are followed by blocks e.g. `if (x) {`, `outer: while (x) {`
+var blockStmtKeywords = map[int]bool{
+	IF:      true,
+	ELSE:    true,
+	FOR:     true,
+	WHILE:   true,
+	DO:      true,
+	SWITCH:  true,
+	TRY:     true,
+	CATCH:   true,
+	FINALLY: true,
+}
+
+// NOTE: operators and keywords followed by an operand, so that '{' after them starts a closure e.g. `def f = {`
+var operandExpected = map[int]bool{
+	'=': true, ASSIGN_OP: true, ',': true, ':': true, '?': true, TERNARY_COLON: true, ELVIS: true,
+	OR: true, AND: true, '|': true, '^': true, '&': true,
+	EQ: true, NE: true, COMPARE: true, FIND: true, MATCH: true,
+	'<': true, '>': true, LE: true, GE: true, IN: true, NOT_IN: true,
+	LSHIFT: true, RSHIFT: true, URSHIFT: true,
+	'+': true, '-': true, '*': true, '/': true, '%': true, POWER: true, '!': true, '~': true,
+	RETURN: true, THROW: true, CASE: true,
+}
+
+// NOTE: binary operators which continue the expression on the next line,
+// the printer breaks lines only after them
+// ':' is not included because of `case x:` and `default:`
+var ContinuationOperators = []string{
+	"?", "?:", "||", "&&", "|", "^", "&",
+	"==", "!=", "===", "!==", "<=>", "=~", "==~",
+	"<", ">", "<=", ">=",
+	"<<", ">>", ">>>",
+	"+", "-", "*", "/", "%", "**",
+}
+
+var continuationTokens = map[int]bool{}
+
+func init() {
+	for _, op := range ContinuationOperators {
+		if token, ok := operators[op]; ok {
+			continuationTokens[token] = true
+		} else {
+			continuationTokens[int(op[0])] = true
+		}
+	}
+}
+
+// bracket tracks open brackets and whether the current statement is in an expression
+func (yylex *Lexer) bracket(token int) {
+	top := &yylex.brackets[len(yylex.brackets)-1]
+	switch {
+	case token == CALL:
+		decl := top.class || yylex.prev2 == IDENT && (yylex.prev3 == DEF || yylex.prev3 == IDENT || yylex.prev3 == MODIFIER)
+		yylex.brackets = append(yylex.brackets, bracket{token: token, expr: true, call: !decl})
+	case token == '(' || token == '[' || token == INDEX || token == PARAMS:
+		yylex.brackets = append(yylex.brackets, bracket{token: token, expr: true})
+	case token == '{' || token == CLOSURE || token == LAMBDA:
+		yylex.brackets = append(yylex.brackets, bracket{token: token, class: top.decl})
+	case token == ')' || token == ']' || token == '}':
+		if len(yylex.brackets) > 1 {
+			yylex.closed = *top
+			yylex.brackets = yylex.brackets[:len(yylex.brackets)-1]
+		}
+	case token == COMMENT:
+	case token == NR || token == ';' || token == CASE_COLON || blockStmtKeywords[token]:
+		// NOTE: new lines in parenthesis don't end expressions
+		if top.token == '{' || top.token == CLOSURE || top.token == LAMBDA || len(yylex.brackets) == 1 {
+			top.expr, top.decl = false, false
+		}
+	// NOTE: `import a.*` is not a multiplication
+	case token == CLASS || token == INTERFACE || token == ENUM || token == IMPORT:
+		top.expr, top.decl = false, true
+	case operandExpected[token] && !top.decl:
+		top.expr = true
+	}
+}
+
+// brace returns CLOSURE or LAMBDA if '{' starts a closure and '{' if it starts a block of statements
+func (yylex *Lexer) brace() int {
+	if yylex.closureParams() {
+		return LAMBDA
+	}
+	top := yylex.brackets[len(yylex.brackets)-1]
+	if top.expr || !top.decl && yylex.trailingClosure() {
+		return CLOSURE
+	}
+	return '{'
+}
+
+// trailingClosure reports whether '{' in statements is a closure argument of a method call
+// e.g. `foo(x) {`, `list.each {`, `a.collect { it }.findAll {`, `parallel stages.collectEntries {`
+// but not blocks of statements e.g. `pipeline {`, `if (x) {`, `def foo(x) {`
+func (yylex *Lexer) trailingClosure() bool {
+	switch yylex.prev {
+	case ')':
+		return yylex.closed.call
+	case IDENT:
+		switch yylex.prev2 {
+		case '.', SAFE_DOT, SPREAD_DOT, METHOD_POINTER, ATTRIBUTE_DOT:
+			return true
+		case IDENT:
+			// NOTE: an argument of a command e.g. `foo bar {`
+			return stmtStartTokens[yylex.prev3]
+		}
+	}
+	return false
+}
+
+// closureParams reports whether the parameters and `->` of a closure follow '{' e.g. `{ k, v ->`, `{ String s ->`, `{ ->`
+func (yylex *Lexer) closureParams() bool {
+	ahead := *yylex
+	ahead.lookahead = true
+	ahead.prev = '{'
+	ahead.colons = append([]pendingColon(nil), yylex.colons...)
+	ahead.brackets = append([]bracket(nil), yylex.brackets...)
+	var lval yySymType
+	depth := 0
+	prev := int('{')
+	for {
+		token := ahead.Lex(&lval)
+		switch token {
+		case ARROW:
+			if depth == 0 {
+				return true
+			}
+		case COMMENT:
+			continue
+		case NR:
+			// NOTE: parameters can start on the next line or continue after ','
+			if depth == 0 && prev != '{' && prev != ',' {
+				return false
+			}
+			continue
+		case '(', '[', INDEX, CALL:
+			depth++
+		case ')', ']':
+			depth--
+		case 0, ';', '{', '}':
+			return false
+		}
+		if depth < 0 || ahead.err != "" {
+			return false
+		}
+		prev = token
+	}
+}
+
+func (yylex *Lexer) setError(msg string) {
+	if yylex.err == "" {
+		yylex.err = msg
+		yylex.errLine, yylex.errColumn = yylex.line, yylex.column
+	}
+}
+
+// isKeyword reports whether the keyword at the current token is not an identifier
+func (yylex *Lexer) isKeyword(token int) bool {
+	switch yylex.prev {
+	case '.', SAFE_DOT, SPREAD_DOT, METHOD_POINTER, ATTRIBUTE_DOT:
+		// NOTE: property names e.g. `params.label`, `x.in`
+		return false
+	}
+	if yylex.isMapKey() {
+		// NOTE: e.g. `[default: 1]`, `f(in: x)`
+		return false
+	}
+	if !softKeywords[token] {
+		return true
+	}
+	if token == ANY || token == NONE {
+		return yylex.prev == AGENT
+	}
+	// NOTE: soft keywords are keywords only at the beginning of statements
+	if !stmtStartTokens[yylex.prev] {
+		return false
+	}
+	// NOTE: followed by arguments e.g. `stage('x') {`, `label 'x'`, `agent any`, `script {`
+	// but not `stage = 'x'`, `label.trim()`, `label: 'x'`
+	next := yylex.pos
+	for next < len(yylex.src) && (yylex.src[next] == ' ' || yylex.src[next] == '\t') {
+		next++
+	}
+	if next == len(yylex.src) {
+		return false
+	}
+	c := yylex.src[next]
+	switch token {
+	case STAGE, DIR:
+		// NOTE: commands with arguments e.g. `stage 'Build'` are parsed as method calls
+		return c == '('
+	case NODE:
+		return c == '(' || c == '{'
+	}
+	return c == '(' || c == '{' || c == '\'' || c == '"' || isIdentChar(c)
+}
+
+// NOTE: tokens after which statements start
+var stmtStartTokens = map[int]bool{
+	0: true, NR: true, ';': true, '{': true, '}': true, CLOSURE: true, LAMBDA: true, ARROW: true,
+}
+
+// methodDecl reports whether '(' at the current token starts the parameters of a method declaration
+// e.g. `String foo() {`, `private void run(x) {`, `String name()` in interfaces and `Foo(x) {` of constructors
+// NOTE: `String foo()` is otherwise a command call `String(foo())` and `Foo(x) {` is a call with a closure
+func (yylex *Lexer) methodDecl() bool {
+	class := yylex.brackets[len(yylex.brackets)-1].class
+	switch {
+	case yylex.prev == IDENT && yylex.prev2 == IDENT && (stmtStartTokens[yylex.prev3] || yylex.prev3 == MODIFIER):
+		if class {
+			return true
+		}
+	case class && yylex.prev == IDENT && (stmtStartTokens[yylex.prev2] || yylex.prev2 == MODIFIER):
+		// NOTE: constructors have the body unlike enum constants e.g. `SMALL(1),`
+	default:
+		return false
+	}
+	// NOTE: followed by the body after ')'
+	ahead := *yylex
+	ahead.lookahead = true
+	ahead.colons = append([]pendingColon(nil), yylex.colons...)
+	ahead.brackets = append([]bracket(nil), yylex.brackets...)
+	var lval yySymType
+	depth := 0
+	for {
+		token := ahead.Lex(&lval)
+		switch token {
+		case COMMENT:
+			continue
+		case '(', '[', INDEX, CALL:
+			depth++
+		case ')', ']':
+			depth--
+		case 0, '}':
+			return false
+		}
+		if depth < 0 || ahead.err != "" {
+			break
+		}
+	}
+	if ahead.err != "" {
+		return false
+	}
+	for {
+		token := ahead.Lex(&lval)
+		if token != COMMENT {
+			return token == '{'
+		}
+	}
+}
+
+// NOTE: keywords which continue if and try statements
+var clauseKeywords = []string{"else", "catch", "finally"}
+
+// clauseFollows reports whether the next word after new lines and line comments is one of clauseKeywords
+// NOTE: only in blocks not to join the lines of e.g. `[\n  else: 1]`
+func (yylex *Lexer) clauseFollows() bool {
+	switch yylex.brackets[len(yylex.brackets)-1].token {
+	case '(', '[', INDEX, CALL, PARAMS:
+		return false
+	}
+	src := yylex.src
+	next := yylex.pos
+	for next < len(src) {
+		if strings.IndexByte(" \t\r\f\n", src[next]) >= 0 {
+			next++
+		} else if bytes.HasPrefix(src[next:], []byte("//")) {
+			i := bytes.IndexByte(src[next:], '\n')
+			if i < 0 {
+				return false
+			}
+			next += i + 1
+		} else {
+			break
+		}
+	}
+	for _, word := range clauseKeywords {
+		end := next + len(word)
+		if bytes.HasPrefix(src[next:], []byte(word)) && (end == len(src) || !isIdentChar(src[end])) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMapKey reports whether the current word is followed by ':' in brackets
+func (yylex *Lexer) isMapKey() bool {
+	switch yylex.brackets[len(yylex.brackets)-1].token {
+	case '(', '[', INDEX, CALL, PARAMS:
+	default:
+		return false
+	}
+	next := yylex.pos
+	for next < len(yylex.src) && (yylex.src[next] == ' ' || yylex.src[next] == '\t') {
+		next++
+	}
+	return next < len(yylex.src) && yylex.src[next] == ':'
+}
+
+// NOTE: primitive types can be array types e.g. `int[]`
+var primitiveTypes = map[string]bool{
+	"boolean": true,
+	"byte":    true,
+	"char":    true,
+	"short":   true,
+	"int":     true,
+	"long":    true,
+	"float":   true,
+	"double":  true,
+}
+
+// scanTypeArguments scans generic type arguments and array brackets following the type name
+// e.g. `Map<String, List<String>>`, `String[]`, so that the whole type is a single IDENT
+// NOTE: only class names (starting with an upper case letter) followed by '<' without spaces are generic types
+func (yylex *Lexer) scanTypeArguments(name string) {
+	src := yylex.src
+	isClass := 'A' <= name[0] && name[0] <= 'Z'
+	if !isClass && !primitiveTypes[name] {
+		return
+	}
+	if isClass && yylex.hasPrefix("<") {
+		depth := 0
+		for i := yylex.pos; i < len(src); i++ {
+			c := src[i]
+			if c == '<' {
+				depth++
+			} else if c == '>' {
+				depth--
+				if depth == 0 {
+					yylex.pos = i + 1
+					break
+				}
+			} else if !isIdentChar(c) && strings.IndexByte(" .,?&[]", c) < 0 || bytes.HasPrefix(src[i:], []byte("&&")) {
+				// NOTE: not a type e.g. `A<b && c>d`, `A<b || c>d` but `<T extends A & B>` is
+				return
+			}
+		}
+	}
+	for yylex.hasPrefix("[]") {
+		yylex.pos += 2
+	}
+	// NOTE: varargs e.g. `String... args`
+	if yylex.hasPrefix("...") {
+		yylex.pos += 3
+	}
+}
+
+// scanNumber scans a numeric literal at pos
+// e.g. `10`, `1_000`, `1.5`, `1e-3`, `0x1F`, `0b101`, `10L`, `2.5G`
+// NOTE: `1.abs()` is NUMBER '.' IDENT
+func (yylex *Lexer) scanNumber() {
+	src := yylex.src
+	digits := func(valid func(c byte) bool) {
+		for yylex.pos < len(src) && (valid(src[yylex.pos]) || src[yylex.pos] == '_') {
+			yylex.pos++
+		}
+	}
+	suffixes := "lLiIgGdDfF"
+	switch {
+	case yylex.hasPrefix("0x"), yylex.hasPrefix("0X"):
+		yylex.pos += 2
+		digits(isHexDigit)
+		suffixes = "lLiIgG"
+	case yylex.hasPrefix("0b"), yylex.hasPrefix("0B"):
+		yylex.pos += 2
+		digits(isDigit)
+		suffixes = "lLiIgG"
+	default:
+		digits(isDigit)
+		if yylex.pos+1 < len(src) && src[yylex.pos] == '.' && isDigit(src[yylex.pos+1]) {
+			yylex.pos++
+			digits(isDigit)
+		}
+		if yylex.pos < len(src) && (src[yylex.pos] == 'e' || src[yylex.pos] == 'E') {
+			exp := yylex.pos + 1
+			if exp < len(src) && (src[exp] == '+' || src[exp] == '-') {
+				exp++
+			}
+			if exp < len(src) && isDigit(src[exp]) {
+				yylex.pos = exp
+				digits(isDigit)
+			}
+		}
+	}
+	if yylex.pos < len(src) && strings.IndexByte(suffixes, src[yylex.pos]) >= 0 {
+		yylex.pos++
+	}
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func (yylex *Lexer) hasPrefix(s string) bool {
+	return bytes.HasPrefix(yylex.src[yylex.pos:], []byte(s))
+}
+
+// setPosition updates line and column to pos
+func (yylex *Lexer) setPosition(pos int) {
+	for _, c := range yylex.src[yylex.lastPos:pos] {
+		switch {
+		case c == '\n':
+			yylex.lastLine++
+			yylex.lastColumn = 0
+		case utf8.RuneStart(c):
+			yylex.lastColumn++
+		}
+	}
+	yylex.lastPos = pos
+	yylex.line, yylex.column = yylex.lastLine, yylex.lastColumn
+}
+
+// skipLineComment skips `// ...` including the new line
+func (yylex *Lexer) skipLineComment() {
+	if i := bytes.IndexByte(yylex.src[yylex.pos:], '\n'); i >= 0 {
+		yylex.pos += i + 1
+	} else {
+		yylex.pos = len(yylex.src)
+	}
+}
+
+func (yylex *Lexer) skipBlockComment() bool {
+	i := bytes.Index(yylex.src[yylex.pos+2:], []byte("*/"))
+	if i < 0 {
+		yylex.pos = len(yylex.src)
+		return false
+	}
+	yylex.pos += 2 + i + 2
+	return true
+}
+
+// dedentComment removes the indent of the continuation lines of a block comment starting at column
+// so that the printer can re-indent them relative to the new position
+// NOTE: Javadoc style leaders are aligned under the first '*' of `/*` e.g. ` * text`
+func dedentComment(text string, column int) string {
+	lines := strings.Split(text, "\n")
+	for i := 1; i < len(lines); i++ {
+		line := lines[i]
+		if trimmed := strings.TrimLeft(line, " \t"); strings.HasPrefix(trimmed, "*") {
+			lines[i] = " " + trimmed
+			continue
+		}
+		for j := 0; j < column && len(line) > 0 && (line[0] == ' ' || line[0] == '\t'); j++ {
+			line = line[1:]
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// scanString scans a string literal at pos
+// double quoted strings (GString) can contain `${ ... }` interpolations
+func (yylex *Lexer) scanString() bool {
+	_, ok := yylex.scanStringInterpolations()
+	return ok
+}
+
+// scanStringInterpolations is scanString which also returns the ranges of the expressions in `${ ... }`
+func (yylex *Lexer) scanStringInterpolations() ([][2]int, bool) {
+	src := yylex.src
+	quote := string(src[yylex.pos : yylex.pos+1])
+	if yylex.hasPrefix(strings.Repeat(quote, 3)) {
+		quote = strings.Repeat(quote, 3)
+	}
+	yylex.pos += len(quote)
+	var ranges [][2]int
+	for yylex.pos < len(src) {
+		switch {
+		case src[yylex.pos] == '\\':
+			yylex.pos += 2
+		case yylex.hasPrefix(quote):
+			yylex.pos += len(quote)
+			return ranges, true
+		case quote[0] == '"' && yylex.hasPrefix("${"):
+			yylex.pos += 2
+			start := yylex.pos
+			if !yylex.skipInterpolation() {
+				return nil, false
+			}
+			ranges = append(ranges, [2]int{start, yylex.pos - 1})
+		default:
+			yylex.pos++
+		}
+	}
+	yylex.pos = len(src)
+	return nil, false
+}
+
+// afterOperand reports whether the last token can end an operand
+// e.g. '/' is a division after `a` or `)` and starts a slashy string after `=` or `(`
+func (yylex *Lexer) afterOperand() bool {
+	switch yylex.prev {
+	case IDENT, STRING, NUMBER, ANY, NONE, INCREMENT, DECREMENT, ')', ']':
+		return true
+	case '}':
+		// NOTE: closures are operands e.g. `xs.sum { it } / n` but blocks are not
+		return yylex.closed.token == CLOSURE || yylex.closed.token == LAMBDA
+	}
+	return false
+}
+
+// scanSlashyString scans `/ ... /` at pos, only `\/` is an escape sequence
+func (yylex *Lexer) scanSlashyString() bool {
+	src := yylex.src
+	yylex.pos++
+	for yylex.pos < len(src) {
+		switch {
+		case yylex.hasPrefix("\\/"):
+			yylex.pos += 2
+		case yylex.hasPrefix("${"):
+			yylex.pos += 2
+			if !yylex.skipInterpolation() {
+				return false
+			}
+		case src[yylex.pos] == '/':
+			yylex.pos++
+			return true
+		default:
+			yylex.pos++
+		}
+	}
+	return false
+}
+
+// scanDollarSlashyString scans `$/ ... /$` at pos, `$$` and `$/` are escape sequences
+func (yylex *Lexer) scanDollarSlashyString() bool {
+	src := yylex.src
+	yylex.pos += 2
+	for yylex.pos < len(src) {
+		switch {
+		case yylex.hasPrefix("$$"), yylex.hasPrefix("$/"):
+			yylex.pos += 2
+		case yylex.hasPrefix("${"):
+			yylex.pos += 2
+			if !yylex.skipInterpolation() {
+				return false
+			}
+		case yylex.hasPrefix("/$"):
+			yylex.pos += 2
+			return true
+		default:
+			yylex.pos++
+		}
+	}
+	return false
+}
+
+// skipInterpolation skips the expression of `${ ... }` including the closing brace
+func (yylex *Lexer) skipInterpolation() bool {
+	src := yylex.src
+	depth := 1
+	for yylex.pos < len(src) {
+		switch c := src[yylex.pos]; {
+		case c == '"' || c == '\'':
+			if !yylex.scanString() {
+				return false
+			}
+		case yylex.hasPrefix("//"):
+			yylex.skipLineComment()
+		case yylex.hasPrefix("/*"):
+			if !yylex.skipBlockComment() {
+				return false
+			}
+		case c == '{':
+			depth++
+			yylex.pos++
+		case c == '}':
+			depth--
+			yylex.pos++
+			if depth == 0 {
+				return true
+			}
+		default:
+			yylex.pos++
+		}
+	}
+	return false
+}
+
+// interpolations returns the ranges of the expressions in `${ ... }` of the string literal s
+func Interpolations(s string) [][2]int {
+	lexer := NewLexer([]byte(s))
+	ranges, ok := lexer.scanStringInterpolations()
+	if !ok {
+		return nil
+	}
+	return ranges
+}
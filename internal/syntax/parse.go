@@ -0,0 +1,168 @@
+// Package syntax parses Jenkinsfiles into the syntax trees for the formatter.
+package syntax
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	// NOTE: report the unexpected token and the expected tokens
+	yyErrorVerbose = true
+}
+
+// Error is a syntax error at Line and Column which start at 1
+type Error struct {
+	Line   int
+	Column int
+	Msg    string
+}
+
+// Parse parses src into the syntax tree
+func Parse(src []byte) (*File, *Error) {
+	lexer := &LexerWrapper{Lexer: NewLexer(src)}
+	if yyParse(lexer) != 0 {
+		return nil, lexer.err
+	}
+	if lexer.Lexer.err != "" {
+		// NOTE: e.g. an unterminated comment at the end of file is accepted by the parser
+		lexer.Error("")
+		return nil, lexer.err
+	}
+	return lexer.file, nil
+}
+
+type LexerWrapper struct {
+	*Lexer
+	file     *File
+	err      *Error
+	comments []string
+	// NOTE: block comments not followed by new lines yet and inline comments attached to tokens of the line
+	pending []string
+	inline  []*InlineComment
+	eof     bool
+
+	// NOTE: the last token for error messages (line and column start at 0)
+	token        int
+	text         string
+	line, column int
+}
+
+// NOTE: tokens which inline comments are attached to as leading comments of Ident or BasicLit
+var leafTokens = map[int]bool{
+	IDENT:  true,
+	STRING: true,
+	NUMBER: true,
+	BOOL:   true,
+	ANY:    true,
+	NONE:   true,
+}
+
+// NOTE: closing brackets which inline comments are attached to e.g. `foo(x /* comment */)`, `{ /* comment */ }`
+var closingTokens = map[int]bool{
+	')': true,
+	']': true,
+	'}': true,
+}
+
+// Lex attaches block comments to the next identifier or literal on the same line (inline comments)
+// or the next new line token
+func (yylex *LexerWrapper) Lex(lval *yySymType) int {
+	if yylex.eof {
+		return 0
+	}
+	for {
+		token := yylex.Lexer.Lex(lval)
+		yylex.setPosition(token)
+		yylex.comments = append(yylex.comments, yylex.Lexer.lineComments...)
+		yylex.Lexer.lineComments = nil
+		lval.comments = nil
+		switch {
+		case token == COMMENT:
+			yylex.pending = append(yylex.pending, lval.str)
+			continue
+		case token == NR || token == 0:
+			yylex.comments = append(yylex.comments, yylex.pending...)
+			yylex.pending = nil
+			lval.comments = yylex.inline
+			yylex.inline = nil
+		case len(yylex.pending) > 0 && (leafTokens[token] || closingTokens[token]):
+			for _, c := range yylex.pending {
+				lval.comments = append(lval.comments, &InlineComment{Text: c})
+			}
+			yylex.inline = append(yylex.inline, lval.comments...)
+			yylex.pending = nil
+		default:
+			// NOTE: e.g. `f(x /* comment */)` is moved to the end of line
+			yylex.comments = append(yylex.comments, yylex.pending...)
+			yylex.pending = nil
+		}
+		switch token {
+		case NR:
+			if len(yylex.comments) > 0 {
+				if lval.str != "" {
+					yylex.comments = append(yylex.comments, lval.str)
+				}
+				lval.str = strings.Join(yylex.comments, " ")
+				yylex.comments = nil
+			}
+		case 0:
+			yylex.eof = true
+			if len(yylex.comments) > 0 {
+				lval.str = strings.Join(yylex.comments, " ")
+				yylex.comments = nil
+				return NR
+			}
+		}
+		return token
+	}
+}
+
+func (yylex *LexerWrapper) setPosition(token int) {
+	yylex.token = token
+	yylex.text = yylex.Text()
+	yylex.line = yylex.Line()
+	yylex.column = yylex.Column()
+}
+
+var (
+	// NOTE: readable names of tokens in goyacc error messages
+	tokenNameReplacer = strings.NewReplacer("$end", "end of file", "$unk", "unknown token",
+		// NOTE: tokens which the lexer tells apart from the same characters are named by their spellings
+		"CALL", "'('", "PARAMS", "'('", "INDEX", "'['", "CLOSURE", "'{'", "LAMBDA", "'{'", "ARROW", "'->'",
+		"TERNARY_COLON", "':'", "CASE_COLON", "':'")
+	newLineTokenRegexp = regexp.MustCompile(`\bNR\b`)
+)
+
+// NOTE: tokens named by their spellings in tokenNameReplacer
+var spelledTokens = map[int]bool{
+	CALL: true, PARAMS: true, INDEX: true, CLOSURE: true, LAMBDA: true, ARROW: true, TERNARY_COLON: true, CASE_COLON: true,
+}
+
+func (yylex *LexerWrapper) Error(e string) {
+	if yylex.Lexer.err != "" {
+		yylex.err = &Error{
+			Line:   yylex.Lexer.errLine + 1,
+			Column: yylex.Lexer.errColumn + 1,
+			Msg:    "syntax error: " + yylex.Lexer.err,
+		}
+		return
+	}
+	unknown := strings.Contains(e, "$unk")
+	msg := tokenNameReplacer.Replace(e)
+	msg = newLineTokenRegexp.ReplaceAllString(msg, "newline")
+	// NOTE: name the unexpected token e.g. `unexpected IDENT "foo"`
+	if yylex.token != 0 && yylex.token != NR && !spelledTokens[yylex.token] && (yylex.token >= yyPrivate || unknown) {
+		if i := strings.Index(msg, ","); i >= 0 {
+			msg = msg[:i] + " " + strconv.Quote(yylex.text) + msg[i:]
+		} else {
+			msg += " " + strconv.Quote(yylex.text)
+		}
+	}
+	yylex.err = &Error{
+		Line:   yylex.line + 1,
+		Column: yylex.column + 1,
+		Msg:    msg,
+	}
+}
@@ -0,0 +1,423 @@
+%{
+package syntax
+%}
+
+%union {
+  str    string
+  stmt   Stmt
+  stmts  []Stmt
+  expr   Expr
+  list   *ExprList
+  block  *Block
+  ifstmt *IfStmt
+  breaks []*LineBreak
+  strs   []string
+  heritage [2][]string
+  class  *ClassDecl
+  cases  []*CaseClause
+  command *CommandStmt
+  // NOTE: inline comments before IDENT, STRING, ... and of the line for NR
+  comments []*InlineComment
+}
+
+// NOTE: '\n'
+%token<str> NR
+%token EOF
+%token<str> COMMENT
+
+%token<str> BOOL
+%token<str> NUMBER
+%token<str> STRING
+// NOTE: 識別子
+%token<str> IDENT
+%token<str> DEF NEW
+%token<str> ANY NONE
+%token<str> SH ECHO
+%token<str> AGENT LABEL STAGE NODE DIR SCRIPT ENVIRONMENT
+%token<str> IMPORT
+%token<str> IF ELSE FOR IN TRY CATCH
+%token<str> INCREMENT DECREMENT
+%token<str> ARROW
+%token<str> AS INSTANCEOF NOT_IN NOT_INSTANCEOF
+%token<str> PACKAGE CLASS INTERFACE ENUM EXTENDS IMPLEMENTS RETURN THROW
+// NOTE: `public`, `static`, `final`, ...
+%token<str> MODIFIER
+%token<str> EQ NE GE LE OR AND
+%token<str> FIND MATCH COMPARE POWER LSHIFT RSHIFT URSHIFT ELVIS SAFE_DOT SPREAD_DOT
+// NOTE: `===` and `!==`, `..` and `..<`, `.&` of method pointers and `.@` of direct field access
+%token<str> IDENTICAL RANGE METHOD_POINTER ATTRIBUTE_DOT
+// NOTE: `+=`, `-=`, ...
+%token<str> ASSIGN_OP
+// NOTE: ':' of `cond ? a : b` and `case x:`
+%token TERNARY_COLON CASE_COLON
+%token<str> SWITCH CASE DEFAULT WHILE DO BREAK CONTINUE FINALLY
+// NOTE: '{' of closures in expressions e.g. `def f = {` and with parameters e.g. `{ k, v ->`
+%token CLOSURE LAMBDA
+// NOTE: '(' right after a method name without spaces e.g. `f(x)`
+%token CALL
+// NOTE: '(' of method declarations e.g. `String foo(` to tell the parameters from the arguments of `String(foo())`
+%token PARAMS
+// NOTE: '[' right after an expression without spaces e.g. `x[0]`
+%token INDEX
+
+%type<stmts> stmts stmt_delimiter
+%type<stmt> stmt
+%type<breaks> nop nrs
+%type<block> block closure
+%type<ifstmt> if_stmt
+%type<str> package modifiers
+%type<list> exprs key_vals params args cmd_args
+%type<expr> key_val expr primary param typed_param arg
+%type<str> type_name
+%type<strs> type_names
+%type<stmt> annotation class_decl func_decl loop_stmt switch_stmt try_stmt
+%type<command> command
+%type<strs> catch_types
+%type<cases> case_clauses
+%type<heritage> class_heritage
+%type<class> enum_body
+
+// NOTE: low priority
+%right ASSIGN_OP
+%right '?' TERNARY_COLON ELVIS
+%left OR
+%left AND
+%left '|'
+%left '^'
+%left '&'
+%left EQ NE IDENTICAL COMPARE FIND MATCH
+%left '<' '>' LE GE IN NOT_IN INSTANCEOF NOT_INSTANCEOF AS
+%left LSHIFT RSHIFT URSHIFT RANGE
+%left '+' '-'
+%left '*' '/' '%'
+%right UNARY_OPERAND '!' '~'
+%right POWER
+%left INCREMENT DECREMENT
+%left '[' ']' INDEX
+%left '(' ')' CALL CLOSURE LAMBDA
+%left '.' SAFE_DOT SPREAD_DOT METHOD_POINTER ATTRIBUTE_DOT
+// NOTE: high priority
+
+%%
+
+file: stmts
+  {
+    yylex.(*LexerWrapper).file = &File{Stmts: $1}
+  }
+
+stmts: /* blank */ { $$ = nil }
+  | stmt { $$ = []Stmt{$1} }
+  | stmt stmt_delimiter stmts { $$ = append(append([]Stmt{$1}, $2...), $3...) }
+  | stmt_delimiter stmts { $$ = append($1, $2...) }
+
+nop: /* blank */ { $$ = nil }
+   | nop nrs { $$ = append($1, $2...) }
+   // | COMMENT
+
+nrs: NR { $$ = []*LineBreak{{Comment: $1, Inline: $<comments>1}} }
+  | nrs NR { $$ = append($1, &LineBreak{Comment: $2, Inline: $<comments>2}) }
+
+stmt_delimiter: ';' { $$ = []Stmt{&Semicolon{}} }
+  | nrs { $$ = lineBreakStmts($1) }
+
+// NOTE: 文
+stmt: IMPORT package { $$ = &ImportStmt{Path: $2} }
+  | IMPORT MODIFIER package { $$ = &ImportStmt{Static: true, Path: $3} }
+  | PACKAGE package { $$ = &PackageStmt{Path: $2} }
+  | annotation { $$ = $1 }
+  // NOTE: `@Library('x') _`, `@Field def x`
+  | annotation stmt { $1.(*Annotation).Stmt = $2; $$ = $1 }
+  | class_decl { $$ = $1 }
+  | func_decl { $$ = $1 }
+  | RETURN { $$ = &ReturnStmt{} }
+  | RETURN expr { $$ = &ReturnStmt{X: $2} }
+  | THROW expr { $$ = &ThrowStmt{X: $2} }
+  | modifiers DEF IDENT { $$ = &DeclStmt{Modifiers: $1, Type: $2, Name: $3} }
+  | modifiers DEF IDENT '=' expr { $$ = &DeclStmt{Modifiers: $1, Type: $2, Name: $3, Value: $5} }
+  | modifiers IDENT IDENT { $$ = &DeclStmt{Modifiers: $1, Type: $2, Name: $3} }
+  | modifiers IDENT IDENT '=' expr { $$ = &DeclStmt{Modifiers: $1, Type: $2, Name: $3, Value: $5} }
+  | modifiers IDENT '=' expr { $$ = &DeclStmt{Modifiers: $1, Name: $2, Value: $4} }
+  // NOTE: commands as values e.g. `def m = readJSON text: result`
+  | modifiers DEF IDENT '=' command { $$ = &DeclStmt{Modifiers: $1, Type: $2, Name: $3, Value: &CommandExpr{Cmd: $5}} }
+  | modifiers IDENT IDENT '=' command { $$ = &DeclStmt{Modifiers: $1, Type: $2, Name: $3, Value: &CommandExpr{Cmd: $5}} }
+  | modifiers IDENT '=' command { $$ = &DeclStmt{Modifiers: $1, Name: $2, Value: &CommandExpr{Cmd: $4}} }
+  // NOTE: for other rules...
+  | expr { $$ = &ExprStmt{X: $1} }
+  | block { $$ = $1 }
+  // NOTE: for other rules...
+  | DEF IDENT { $$ = &DeclStmt{Type: $1, Name: $2} }
+  // NOTE: for other rules...
+  | DEF IDENT '=' expr { $$ = &DeclStmt{Type: $1, Name: $2, Value: $4} }
+  | IDENT IDENT '=' expr { $$ = &DeclStmt{Type: $1, Name: $2, Value: $4} }
+  | expr '=' expr { $$ = &AssignStmt{Lhs: $1, Rhs: $3} }
+  | DEF IDENT '=' command { $$ = &DeclStmt{Type: $1, Name: $2, Value: &CommandExpr{Cmd: $4}} }
+  | IDENT IDENT '=' command { $$ = &DeclStmt{Type: $1, Name: $2, Value: &CommandExpr{Cmd: $4}} }
+  | expr '=' command { $$ = &AssignStmt{Lhs: $1, Rhs: &CommandExpr{Cmd: $3}} }
+  // NOTE: for other rules...
+  | command { $$ = newCommand($1) }
+  | AGENT ANY { $$ = &CommandStmt{Name: $1, Args: newExprList(&Ident{Name: $2, Comments: $<comments>2})} }
+  | AGENT NONE { $$ = &CommandStmt{Name: $1, Args: newExprList(&Ident{Name: $2, Comments: $<comments>2})} }
+  | AGENT block { $$ = &BlockCallStmt{Fun: &Ident{Name: $1}, Body: $2} }
+  // NOTE: for other rules...
+  | IDENT block { $$ = &BlockCallStmt{Fun: &Ident{Name: $1}, Body: $2} }
+  | SCRIPT block { $$ = &BlockCallStmt{Fun: &Ident{Name: $1}, Body: $2} }
+  // WARN: environment block rule is near script rule block
+  | ENVIRONMENT block { $$ = &BlockCallStmt{Fun: &Ident{Name: $1}, Body: $2} }
+  | STAGE '(' nop expr nop ')' block { $$ = &BlockCallStmt{Fun: &Ident{Name: $1}, Args: newExprList($4).enclose($3, $5), Body: $7} }
+  | NODE '(' nop expr nop ')' block { $$ = &BlockCallStmt{Fun: &Ident{Name: $1}, Args: newExprList($4).enclose($3, $5), Body: $7} }
+  | NODE block { $$ = &BlockCallStmt{Fun: &Ident{Name: $1}, Body: $2} }
+  | DIR '(' nop expr nop ')' block { $$ = &BlockCallStmt{Fun: &Ident{Name: $1}, Args: newExprList($4).enclose($3, $5), Body: $7} }
+  // NOTE: for other rules...
+  | IDENT '(' nop expr nop ')' block { $$ = &BlockCallStmt{Fun: &Ident{Name: $1}, Args: newExprList($4).enclose($3, $5), Body: $7} }
+  // NOTE: for other rules...
+  | IDENT '(' nop key_vals nop ')' block { $$ = &BlockCallStmt{Fun: &Ident{Name: $1}, Args: $4.enclose($3, $5), Body: $7} }
+  | if_stmt { $$ = $1 }
+  | loop_stmt { $$ = $1 }
+  // NOTE: labeled loop for `break label`
+  | IDENT ':' nop loop_stmt { $$ = &LabeledStmt{Label: $1, Breaks: $3, Stmt: $4} }
+  | switch_stmt { $$ = $1 }
+  | try_stmt { $$ = $1 }
+  | BREAK { $$ = &BranchStmt{Tok: $1} }
+  | BREAK IDENT { $$ = &BranchStmt{Tok: $1, Label: $2} }
+  | CONTINUE { $$ = &BranchStmt{Tok: $1} }
+  | CONTINUE IDENT { $$ = &BranchStmt{Tok: $1, Label: $2} }
+  | expr block { $$ = &BlockCallStmt{Fun: $1, Body: $2} }
+
+block : '{' stmts '}' { $$ = &Block{Stmts: $2, Closing: $<comments>3} }
+
+// NOTE: method calls without parenthesis e.g. `mail to: 'x', subject: 'y'` and command chains e.g. `please show the square_root of 100`
+command: IDENT cmd_args { $$ = &CommandStmt{Name: $1, Args: $2} }
+  | SH cmd_args { $$ = &CommandStmt{Name: $1, Args: $2} }
+  | ECHO cmd_args { $$ = &CommandStmt{Name: $1, Args: $2} }
+  | LABEL cmd_args { $$ = &CommandStmt{Name: $1, Args: $2} }
+  | ENVIRONMENT cmd_args { $$ = &CommandStmt{Name: $1, Args: $2} }
+  // NOTE: methods of objects e.g. `steps.sh 'make'`
+  | expr '.' IDENT cmd_args { $$ = &CommandStmt{Fun: &SelectorExpr{X: $1, Op: ".", Sel: $3}, Args: $4} }
+  | expr SAFE_DOT IDENT cmd_args { $$ = &CommandStmt{Fun: &SelectorExpr{X: $1, Op: $2, Sel: $3}, Args: $4} }
+  | command IDENT cmd_args { $$ = &CommandStmt{Recv: $1, Name: $2, Args: $3} }
+  | command IDENT { $$ = &CommandStmt{Recv: $1, Name: $2} }
+
+cmd_args: arg { $$ = newExprList($1) }
+  | cmd_args ',' nop arg { $$ = $1.append($3, $4) }
+
+closure: CLOSURE stmts '}' { $$ = &Block{Stmts: $2, Closing: $<comments>3} }
+  | LAMBDA nop params ARROW stmts '}' { $$ = &Block{Params: $3.enclose($2, nil), Stmts: $5, Closing: $<comments>6} }
+
+// NOTE: `x in xs` is parsed as an expression because `in` is also an operator
+// NOTE: the bodies of loops and if statements are blocks or statements without braces e.g. `while (x) x--`
+loop_stmt: FOR '(' expr ')' stmt
+    {
+      forIn := newForIn("", $3, $5)
+      if forIn == nil {
+        yylex.Error("syntax error: expecting `for (x in xs)`")
+        goto ret1
+      }
+      $$ = forIn
+    }
+  // NOTE: typed variables e.g. `for (String x in xs)`
+  | FOR '(' IDENT expr ')' stmt
+    {
+      forIn := newForIn($3, $4, $6)
+      if forIn == nil {
+        yylex.Error("syntax error: expecting `for (Type x in xs)`")
+        goto ret1
+      }
+      $$ = forIn
+    }
+  | FOR '(' DEF IDENT IN expr ')' stmt { $$ = &ForInStmt{Type: $3, Var: $4, X: $6, Body: $8} }
+  | FOR '(' stmt ';' expr ';' expr ')' stmt { $$ = &ForStmt{Init: $3, Cond: $5, Post: $7, Body: $9} }
+  | WHILE '(' nop expr nop ')' stmt { $$ = &WhileStmt{Cond: &ParenExpr{X: $4, Lparen: $3, Rparen: $5}, Body: $7} }
+  | DO block WHILE expr { $$ = &DoWhileStmt{Body: $2, Cond: $4} }
+
+switch_stmt: SWITCH expr '{' nop case_clauses '}' { $$ = &SwitchStmt{Tag: $2, Breaks: $4, Cases: $5} }
+
+case_clauses: /* blank */ { $$ = nil }
+  | case_clauses CASE expr CASE_COLON stmts { $$ = append($1, &CaseClause{Value: $3, Body: $5}) }
+  | case_clauses DEFAULT ':' stmts { $$ = append($1, &CaseClause{Body: $4}) }
+
+try_stmt: TRY block { $$ = &TryStmt{Body: $2} }
+  | try_stmt CATCH '(' catch_types IDENT ')' block { $1.(*TryStmt).Catches = append($1.(*TryStmt).Catches, &CatchClause{Types: $4, Name: $5, Body: $7}); $$ = $1 }
+  | try_stmt CATCH '(' IDENT ')' block { $1.(*TryStmt).Catches = append($1.(*TryStmt).Catches, &CatchClause{Name: $4, Body: $6}); $$ = $1 }
+  | try_stmt FINALLY block { $1.(*TryStmt).Finally = $3; $$ = $1 }
+
+// NOTE: multi-catch `IOException | InterruptedException`
+catch_types: type_name { $$ = []string{$1} }
+  | catch_types '|' type_name { $$ = append($1, $3) }
+
+modifiers: MODIFIER { $$ = $1 }
+  | modifiers MODIFIER { $$ = $1 + " " + $2 }
+
+annotation: '@' type_name { $$ = &Annotation{Name: $2} }
+  | '@' type_name CALL nop args nop ')' { $$ = &Annotation{Name: $2, Args: $5.enclose($4, $6)} }
+
+// NOTE: methods of interfaces have no body
+func_decl: DEF IDENT lparen nop params nop ')' block { $$ = &FuncDecl{Type: $1, Name: $2, Params: $5.enclose($4, $6), Body: $8} }
+  | IDENT IDENT CALL nop params nop ')' block { $$ = &FuncDecl{Type: $1, Name: $2, Params: $5.enclose($4, $6), Body: $8} }
+  | IDENT IDENT PARAMS nop params nop ')' block { $$ = &FuncDecl{Type: $1, Name: $2, Params: $5.enclose($4, $6), Body: $8} }
+  | modifiers DEF IDENT lparen nop params nop ')' block { $$ = &FuncDecl{Modifiers: $1, Type: $2, Name: $3, Params: $6.enclose($5, $7), Body: $9} }
+  | modifiers IDENT IDENT CALL nop params nop ')' block { $$ = &FuncDecl{Modifiers: $1, Type: $2, Name: $3, Params: $6.enclose($5, $7), Body: $9} }
+  | modifiers IDENT IDENT PARAMS nop params nop ')' block { $$ = &FuncDecl{Modifiers: $1, Type: $2, Name: $3, Params: $6.enclose($5, $7), Body: $9} }
+  // NOTE: constructor
+  | IDENT PARAMS nop params nop ')' block { $$ = &FuncDecl{Name: $1, Params: $4.enclose($3, $5), Body: $7} }
+  | modifiers IDENT PARAMS nop params nop ')' block { $$ = &FuncDecl{Modifiers: $1, Name: $2, Params: $5.enclose($4, $6), Body: $8} }
+  | modifiers IDENT IDENT CALL nop params nop ')' { $$ = &FuncDecl{Modifiers: $1, Type: $2, Name: $3, Params: $6.enclose($5, $7)} }
+  | IDENT IDENT CALL nop params nop ')' { $$ = &FuncDecl{Type: $1, Name: $2, Params: $5.enclose($4, $6)} }
+  | modifiers IDENT IDENT PARAMS nop params nop ')' { $$ = &FuncDecl{Modifiers: $1, Type: $2, Name: $3, Params: $6.enclose($5, $7)} }
+  | IDENT IDENT PARAMS nop params nop ')' { $$ = &FuncDecl{Type: $1, Name: $2, Params: $5.enclose($4, $6)} }
+
+// NOTE: `def f (x)` is also a method declaration
+lparen: '(' | CALL
+
+class_decl: CLASS IDENT class_heritage block { $$ = newClassDecl("", $1, $2, $3, $4) }
+  | modifiers CLASS IDENT class_heritage block { $$ = newClassDecl($1, $2, $3, $4, $5) }
+  | INTERFACE IDENT class_heritage block { $$ = newClassDecl("", $1, $2, $3, $4) }
+  | modifiers INTERFACE IDENT class_heritage block { $$ = newClassDecl($1, $2, $3, $4, $5) }
+  | ENUM IDENT class_heritage enum_body { $4.Modifiers, $4.Kind, $4.Name = "", $1, $2; $4.Implements = $3[1]; $$ = $4 }
+  | modifiers ENUM IDENT class_heritage enum_body { $5.Modifiers, $5.Kind, $5.Name = $1, $2, $3; $5.Implements = $4[1]; $$ = $5 }
+
+// NOTE: [extends, implements]
+class_heritage: /* blank */ { $$ = [2][]string{} }
+  | class_heritage EXTENDS type_names { $1[0] = $3; $$ = $1 }
+  | class_heritage IMPLEMENTS type_names { $1[1] = $3; $$ = $1 }
+
+type_names: type_name { $$ = []string{$1} }
+  | type_names ',' type_name { $$ = append($1, $3) }
+
+// NOTE: constants and then members after ';'
+enum_body: '{' nop exprs nop '}' { $$ = &ClassDecl{Constants: $3.enclose($2, $4), Body: &Block{}} }
+  | '{' nop exprs ',' nop '}' { $3.Comma = true; $$ = &ClassDecl{Constants: $3.enclose($2, $5), Body: &Block{}} }
+  | '{' nop exprs ';' stmts '}' { $$ = &ClassDecl{Constants: $3.enclose($2, nil), Body: &Block{Stmts: $5}} }
+
+// NOTE: the condition is in parenthesis to know where the body without braces starts e.g. `if (a) -b`
+// NOTE: `else` belongs to the nearest if statement e.g. `if (a) if (b) x() else y()`
+if_stmt: IF '(' nop expr nop ')' stmt { $$ = &IfStmt{Cond: &ParenExpr{X: $4, Lparen: $3, Rparen: $5}, Then: $7} }
+  | if_stmt ELSE stmt { $1.lastIf().Else = $3; $$ = $1 }
+
+package: IDENT { $$ = $1 }
+    | '*' { $$ = "*" }
+    | IDENT '.' package { $$ = $1 + "." + $3 }
+
+exprs: /* blank */ { $$ = &ExprList{} }
+    | expr { $$ = newExprList($1) }
+    | exprs ',' nop expr { $$ = $1.append($3, $4) }
+
+key_vals: key_val { $$ = newExprList($1) }
+    | key_vals ',' nop key_val { $$ = $1.append($3, $4) }
+
+// NOTE: %prec to take the longest value e.g. `a: b + c`
+key_val: IDENT ':' expr %prec ASSIGN_OP { $$ = &KeyValue{Key: &Ident{Name: $1, Comments: $<comments>1}, Value: $3} }
+    | NUMBER ':' expr %prec ASSIGN_OP { $$ = &KeyValue{Key: &BasicLit{Kind: NUMBER, Value: $1, Comments: $<comments>1}, Value: $3} }
+    | STRING ':' expr %prec ASSIGN_OP { $$ = &KeyValue{Key: &BasicLit{Kind: STRING, Value: $1, Comments: $<comments>1}, Value: $3} }
+    // NOTE: the key is the value of the expression e.g. `[(name): 1]`
+    // NOTE: `nop` not to conflict with `(key: value)` before the first token
+    | '(' nop expr nop ')' ':' expr %prec ASSIGN_OP { $$ = &KeyValue{Key: &ParenExpr{X: $3, Lparen: $2, Rparen: $4}, Value: $7} }
+    // NOTE: spread map e.g. `[*: defaults, a: 1]`
+    | '*' ':' expr %prec ASSIGN_OP { $$ = &KeyValue{Value: $3} }
+
+// NOTE: defined after exprs to prefer exprs on reduce/reduce conflicts e.g. `f()`
+// NOTE: arguments of method calls
+args: /* blank */ { $$ = &ExprList{} }
+    | arg { $$ = newExprList($1) }
+    | args ',' nop arg { $$ = $1.append($3, $4) }
+
+arg: expr { $$ = $1 }
+    | key_val { $$ = $1 }
+
+params: /* blank */ { $$ = &ExprList{} }
+    | param { $$ = newExprList($1) }
+    | params ',' nop param { $$ = $1.append($3, $4) }
+
+param: expr { $$ = $1 }
+    | typed_param { $$ = $1 }
+
+typed_param: DEF IDENT { $$ = &Param{Type: $1, Name: $2} }
+    | DEF IDENT '=' expr { $$ = &Param{Type: $1, Name: $2, Default: $4} }
+    | IDENT IDENT { $$ = &Param{Type: $1, Name: $2} }
+    | IDENT IDENT '=' expr { $$ = &Param{Type: $1, Name: $2, Default: $4} }
+    | IDENT '=' expr { $$ = &Param{Name: $1, Default: $3} }
+
+// NOTE: 式
+expr: primary { $$ = $1 }
+    | '[' nop exprs nop ']' { $$ = &ListLit{Elems: $3.enclose($2, $4).close($<comments>5)} }
+    | '[' nop exprs ',' nop ']' { $3.Comma = true; $$ = &ListLit{Elems: $3.enclose($2, $5).close($<comments>6)} }
+    | '[' nop key_vals nop ']' { $$ = &MapLit{Elems: $3.enclose($2, $4).close($<comments>5)} }
+    | '[' nop ':' nop ']' { $$ = &MapLit{Elems: (&ExprList{}).enclose($2, $4)} }
+    | '[' nop key_vals ',' nop ']' { $3.Comma = true; $$ = &MapLit{Elems: $3.enclose($2, $5).close($<comments>6)} }
+    // NOTE: duplicate rule but need for func()
+    | IDENT CALL nop args nop ')' { $$ = &CallExpr{Fun: &Ident{Name: $1, Comments: $<comments>1}, Args: $4.enclose($3, $5).close($<comments>6)} }
+    // func call
+    | expr CALL nop args nop ')' { $$ = &CallExpr{Fun: $1, Args: $4.enclose($3, $5).close($<comments>6)} }
+    // NOTE: for exception
+    | SH '(' nop key_vals nop ')' { $$ = &CallExpr{Fun: &Ident{Name: $1}, Args: $4.enclose($3, $5)} }
+    | closure { $$ = &ClosureExpr{Body: $1} }
+    // NOTE: trailing closure e.g. `xs.collect { it * 2 }`, `f(x) { ... }`
+    | expr closure { $$ = newClosureCall($1, $2) }
+    | '(' nop key_vals nop ')' { $$ = &ParenExpr{X: &NamedArgs{Elems: $3.enclose($2, $4)}} }
+    | expr '.' IDENT %prec '.' { $$ = &SelectorExpr{X: $1, Op: ".", Sel: $3} }
+    | expr SAFE_DOT IDENT %prec '.' { $$ = &SelectorExpr{X: $1, Op: $2, Sel: $3} }
+    | expr SPREAD_DOT IDENT %prec '.' { $$ = &SelectorExpr{X: $1, Op: $2, Sel: $3} }
+    | expr METHOD_POINTER IDENT %prec '.' { $$ = &SelectorExpr{X: $1, Op: $2, Sel: $3} }
+    | expr ATTRIBUTE_DOT IDENT %prec '.' { $$ = &SelectorExpr{X: $1, Op: $2, Sel: $3} }
+    | expr INDEX nop exprs nop ']' { $$ = &IndexExpr{X: $1, Index: $4.enclose($3, $5)} }
+    | NEW IDENT CALL nop args nop ')' { $$ = &NewExpr{Type: $2, Args: $5.enclose($4, $6).close($<comments>7)} }
+    | '-' expr %prec UNARY_OPERAND { $$ = &UnaryExpr{Op: "-", X: $2} }
+    | '+' expr %prec UNARY_OPERAND { $$ = &UnaryExpr{Op: "+", X: $2} }
+    | '!' expr { $$ = &UnaryExpr{Op: "!", X: $2} }
+    | '~' expr { $$ = &UnaryExpr{Op: "~", X: $2} }
+    // NOTE: spread e.g. `[*xs, 1]`, `f(*args)`
+    | '*' expr %prec UNARY_OPERAND { $$ = &UnaryExpr{Op: "*", X: $2} }
+    | INCREMENT expr %prec UNARY_OPERAND { $$ = &UnaryExpr{Op: $1, X: $2} }
+    | DECREMENT expr %prec UNARY_OPERAND { $$ = &UnaryExpr{Op: $1, X: $2} }
+    | expr '?' expr TERNARY_COLON expr { $$ = &CondExpr{Cond: $1, Then: $3, Else: $5} }
+    | expr ELVIS expr { $$ = &BinaryExpr{X: $1, Op: $2, Y: $3} }
+    | expr ASSIGN_OP expr { $$ = &BinaryExpr{X: $1, Op: $2, Y: $3} }
+    | expr POWER expr { $$ = &BinaryExpr{X: $1, Op: $2, Y: $3} }
+    | expr LSHIFT expr { $$ = &BinaryExpr{X: $1, Op: $2, Y: $3} }
+    | expr RSHIFT expr { $$ = &BinaryExpr{X: $1, Op: $2, Y: $3} }
+    | expr URSHIFT expr { $$ = &BinaryExpr{X: $1, Op: $2, Y: $3} }
+    | expr RANGE expr { $$ = &BinaryExpr{X: $1, Op: $2, Y: $3} }
+    | expr '&' expr { $$ = &BinaryExpr{X: $1, Op: "&", Y: $3} }
+    | expr '^' expr { $$ = &BinaryExpr{X: $1, Op: "^", Y: $3} }
+    | expr '|' expr { $$ = &BinaryExpr{X: $1, Op: "|", Y: $3} }
+    | expr COMPARE expr { $$ = &BinaryExpr{X: $1, Op: $2, Y: $3} }
+    | expr FIND expr { $$ = &BinaryExpr{X: $1, Op: $2, Y: $3} }
+    | expr MATCH expr { $$ = &BinaryExpr{X: $1, Op: $2, Y: $3} }
+    | expr IN expr { $$ = &BinaryExpr{X: $1, Op: $2, Y: $3} }
+    | expr NOT_IN expr { $$ = &BinaryExpr{X: $1, Op: $2, Y: $3} }
+    | expr AS type_name { $$ = &BinaryExpr{X: $1, Op: $2, Y: &Ident{Name: $3}} }
+    | expr INSTANCEOF type_name { $$ = &BinaryExpr{X: $1, Op: $2, Y: &Ident{Name: $3}} }
+    | expr NOT_INSTANCEOF type_name { $$ = &BinaryExpr{X: $1, Op: $2, Y: &Ident{Name: $3}} }
+    | expr '<' expr { $$ = &BinaryExpr{X: $1, Op: "<", Y: $3} }
+    | expr '>' expr { $$ = &BinaryExpr{X: $1, Op: ">", Y: $3} }
+    | expr '-' expr { $$ = &BinaryExpr{X: $1, Op: "-", Y: $3} }
+    | expr '+' expr { $$ = &BinaryExpr{X: $1, Op: "+", Y: $3} }
+    | expr '*' expr { $$ = &BinaryExpr{X: $1, Op: "*", Y: $3} }
+    | expr '/' expr { $$ = &BinaryExpr{X: $1, Op: "/", Y: $3} }
+    | expr '%' expr { $$ = &BinaryExpr{X: $1, Op: "%", Y: $3} }
+    | expr EQ expr { $$ = &BinaryExpr{X: $1, Op: "==", Y: $3} }
+    | expr NE expr { $$ = &BinaryExpr{X: $1, Op: "!=", Y: $3} }
+    | expr IDENTICAL expr { $$ = &BinaryExpr{X: $1, Op: $2, Y: $3} }
+    | expr GE expr { $$ = &BinaryExpr{X: $1, Op: ">=", Y: $3} }
+    | expr LE expr { $$ = &BinaryExpr{X: $1, Op: "<=", Y: $3} }
+    | expr AND expr { $$ = &BinaryExpr{X: $1, Op: "&&", Y: $3} }
+    | expr OR expr { $$ = &BinaryExpr{X: $1, Op: "||", Y: $3} }
+    // NOTE: `i++` at the beginning of statements is not a command call `i(++...)`
+    | IDENT INCREMENT { $$ = &IncDecExpr{X: &Ident{Name: $1}, Op: $2} }
+    | IDENT DECREMENT { $$ = &IncDecExpr{X: &Ident{Name: $1}, Op: $2} }
+    | expr INCREMENT { $$ = &IncDecExpr{X: $1, Op: $2} }
+    | expr DECREMENT { $$ = &IncDecExpr{X: $1, Op: $2} }
+
+// NOTE: `x as java.util.List`
+type_name: IDENT { $$ = $1 }
+    | type_name '.' IDENT { $$ = $1 + "." + $3 }
+
+// NOTE: 項
+primary : NUMBER { $$ = &BasicLit{Kind: NUMBER, Value: $1, Comments: $<comments>1} }
+        | STRING { $$ = &BasicLit{Kind: STRING, Value: $1, Comments: $<comments>1} }
+        | BOOL { $$ = &BasicLit{Kind: BOOL, Value: $1, Comments: $<comments>1} }
+        // NOTE: `a -1` is `a - 1` not a command call `a(-1)` as groovy does
+        | IDENT %prec '*' { $$ = &Ident{Name: $1, Comments: $<comments>1} }
+        // NOTE: `nop` not to conflict with `(key: value)` before the first token, the line breaks are dropped
+        | '(' nop expr nop ')' { $$ = &ParenExpr{X: $3, Lparen: $2, Rparen: $4} }
+
+%%
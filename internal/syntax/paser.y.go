@@ -0,0 +1,2516 @@
+// Code generated by goyacc -o paser.y.go -v /tmp/y.output parser.y. DO NOT EDIT.
+
+//line parser.y:2
+package syntax
+
+import __yyfmt__ "fmt"
+
+//line parser.y:2
+
+//line parser.y:5
+type yySymType struct {
+	yys      int
+	str      string
+	stmt     Stmt
+	stmts    []Stmt
+	expr     Expr
+	list     *ExprList
+	block    *Block
+	ifstmt   *IfStmt
+	breaks   []*LineBreak
+	strs     []string
+	heritage [2][]string
+	class    *ClassDecl
+	cases    []*CaseClause
+	command  *CommandStmt
+	// NOTE: inline comments before IDENT, STRING, ... and of the line for NR
+	comments []*InlineComment
+}
+
+const NR = 57346
+const EOF = 57347
+const COMMENT = 57348
+const BOOL = 57349
+const NUMBER = 57350
+const STRING = 57351
+const IDENT = 57352
+const DEF = 57353
+const NEW = 57354
+const ANY = 57355
+const NONE = 57356
+const SH = 57357
+const ECHO = 57358
+const AGENT = 57359
+const LABEL = 57360
+const STAGE = 57361
+const NODE = 57362
+const DIR = 57363
+const SCRIPT = 57364
+const ENVIRONMENT = 57365
+const IMPORT = 57366
+const IF = 57367
+const ELSE = 57368
+const FOR = 57369
+const IN = 57370
+const TRY = 57371
+const CATCH = 57372
+const INCREMENT = 57373
+const DECREMENT = 57374
+const ARROW = 57375
+const AS = 57376
+const INSTANCEOF = 57377
+const NOT_IN = 57378
+const NOT_INSTANCEOF = 57379
+const PACKAGE = 57380
+const CLASS = 57381
+const INTERFACE = 57382
+const ENUM = 57383
+const EXTENDS = 57384
+const IMPLEMENTS = 57385
+const RETURN = 57386
+const THROW = 57387
+const MODIFIER = 57388
+const EQ = 57389
+const NE = 57390
+const GE = 57391
+const LE = 57392
+const OR = 57393
+const AND = 57394
+const FIND = 57395
+const MATCH = 57396
+const COMPARE = 57397
+const POWER = 57398
+const LSHIFT = 57399
+const RSHIFT = 57400
+const URSHIFT = 57401
+const ELVIS = 57402
+const SAFE_DOT = 57403
+const SPREAD_DOT = 57404
+const IDENTICAL = 57405
+const RANGE = 57406
+const METHOD_POINTER = 57407
+const ATTRIBUTE_DOT = 57408
+const ASSIGN_OP = 57409
+const TERNARY_COLON = 57410
+const CASE_COLON = 57411
+const SWITCH = 57412
+const CASE = 57413
+const DEFAULT = 57414
+const WHILE = 57415
+const DO = 57416
+const BREAK = 57417
+const CONTINUE = 57418
+const FINALLY = 57419
+const CLOSURE = 57420
+const LAMBDA = 57421
+const CALL = 57422
+const PARAMS = 57423
+const INDEX = 57424
+const UNARY_OPERAND = 57425
+
+var yyToknames = [...]string{
+	"$end",
+	"error",
+	"$unk",
+	"NR",
+	"EOF",
+	"COMMENT",
+	"BOOL",
+	"NUMBER",
+	"STRING",
+	"IDENT",
+	"DEF",
+	"NEW",
+	"ANY",
+	"NONE",
+	"SH",
+	"ECHO",
+	"AGENT",
+	"LABEL",
+	"STAGE",
+	"NODE",
+	"DIR",
+	"SCRIPT",
+	"ENVIRONMENT",
+	"IMPORT",
+	"IF",
+	"ELSE",
+	"FOR",
+	"IN",
+	"TRY",
+	"CATCH",
+	"INCREMENT",
+	"DECREMENT",
+	"ARROW",
+	"AS",
+	"INSTANCEOF",
+	"NOT_IN",
+	"NOT_INSTANCEOF",
+	"PACKAGE",
+	"CLASS",
+	"INTERFACE",
+	"ENUM",
+	"EXTENDS",
+	"IMPLEMENTS",
+	"RETURN",
+	"THROW",
+	"MODIFIER",
+	"EQ",
+	"NE",
+	"GE",
+	"LE",
+	"OR",
+	"AND",
+	"FIND",
+	"MATCH",
+	"COMPARE",
+	"POWER",
+	"LSHIFT",
+	"RSHIFT",
+	"URSHIFT",
+	"ELVIS",
+	"SAFE_DOT",
+	"SPREAD_DOT",
+	"IDENTICAL",
+	"RANGE",
+	"METHOD_POINTER",
+	"ATTRIBUTE_DOT",
+	"ASSIGN_OP",
+	"TERNARY_COLON",
+	"CASE_COLON",
+	"SWITCH",
+	"CASE",
+	"DEFAULT",
+	"WHILE",
+	"DO",
+	"BREAK",
+	"CONTINUE",
+	"FINALLY",
+	"CLOSURE",
+	"LAMBDA",
+	"CALL",
+	"PARAMS",
+	"INDEX",
+	"'?'",
+	"'|'",
+	"'^'",
+	"'&'",
+	"'<'",
+	"'>'",
+	"'+'",
+	"'-'",
+	"'*'",
+	"'/'",
+	"'%'",
+	"UNARY_OPERAND",
+	"'!'",
+	"'~'",
+	"'['",
+	"']'",
+	"'('",
+	"')'",
+	"'.'",
+	"';'",
+	"'='",
+	"':'",
+	"'{'",
+	"'}'",
+	"','",
+	"'@'",
+}
+
+var yyStatenames = [...]string{}
+
+const yyEofCode = 1
+const yyErrCode = 2
+const yyInitialStackSize = 16
+
+//line parser.y:423
+
+//line yacctab:1
+var yyExca = [...]int16{
+	-1, 1,
+	1, -1,
+	-2, 0,
+	-1, 478,
+	10, 165,
+	107, 165,
+	-2, 162,
+}
+
+const yyPrivate = 57344
+
+const yyLast = 3138
+
+var yyAct = [...]int16{
+	13, 339, 300, 421, 356, 251, 376, 280, 59, 138,
+	514, 73, 76, 299, 286, 513, 25, 139, 196, 197,
+	133, 139, 377, 398, 472, 364, 486, 487, 400, 422,
+	276, 260, 360, 358, 543, 503, 50, 446, 488, 298,
+	139, 150, 378, 17, 173, 174, 175, 176, 177, 178,
+	179, 289, 139, 139, 50, 145, 146, 140, 187, 67,
+	169, 485, 163, 196, 197, 154, 71, 255, 256, 353,
+	354, 50, 181, 182, 503, 521, 263, 275, 265, 264,
+	377, 257, 279, 31, 206, 444, 31, 31, 312, 313,
+	254, 257, 372, 200, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
+	531, 311, 135, 236, 237, 238, 239, 240, 241, 242,
+	243, 244, 245, 246, 247, 248, 249, 207, 192, 353,
+	354, 193, 357, 31, 468, 379, 257, 178, 179, 233,
+	234, 235, 177, 419, 272, 139, 31, 50, 31, 59,
+	62, 142, 143, 151, 198, 42, 274, 2, 75, 14,
+	59, 185, 66, 59, 202, 266, 184, 378, 183, 283,
+	196, 197, 287, 84, 156, 438, 130, 275, 147, 148,
+	149, 253, 155, 281, 253, 291, 201, 59, 196, 197,
+	59, 301, 50, 59, 383, 384, 382, 277, 278, 59,
+	252, 153, 314, 252, 309, 310, 288, 250, 180, 139,
+	139, 139, 139, 139, 322, 59, 186, 283, 188, 135,
+	3, 189, 323, 191, 324, 417, 284, 253, 72, 284,
+	150, 320, 321, 59, 317, 318, 319, 135, 45, 46,
+	38, 59, 152, 257, 59, 315, 252, 30, 59, 296,
+	250, 326, 59, 305, 59, 329, 538, 456, 333, 334,
+	379, 59, 301, 139, 340, 341, 342, 59, 343, 344,
+	345, 346, 323, 335, 59, 338, 336, 386, 59, 59,
+	59, 69, 157, 525, 477, 385, 343, 59, 59, 524,
+	59, 368, 158, 370, 327, 523, 373, 374, 330, 418,
+	363, 59, 349, 59, 59, 383, 384, 382, 59, 59,
+	387, 515, 390, 59, 59, 59, 284, 301, 59, 273,
+	59, 40, 164, 381, 397, 284, 402, 429, 144, 301,
+	394, 380, 405, 301, 371, 88, 69, 510, 139, 159,
+	501, 323, 403, 284, 500, 404, 407, 410, 499, 351,
+	497, 139, 420, 388, 348, 391, 307, 492, 423, 59,
+	323, 306, 70, 484, 196, 197, 284, 215, 139, 434,
+	480, 214, 68, 439, 478, 467, 466, 323, 271, 213,
+	445, 211, 54, 465, 464, 210, 463, 205, 386, 204,
+	301, 78, 77, 301, 301, 88, 385, 203, 88, 462,
+	461, 199, 172, 449, 460, 294, 450, 451, 167, 454,
+	433, 432, 428, 135, 426, 166, 165, 70, 161, 160,
+	80, 81, 82, 128, 162, 283, 474, 79, 55, 56,
+	4, 1, 473, 441, 65, 347, 352, 355, 27, 482,
+	26, 9, 8, 7, 301, 490, 489, 491, 469, 493,
+	302, 84, 130, 37, 139, 12, 474, 24, 0, 31,
+	0, 88, 0, 498, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 395, 396, 0,
+	0, 0, 0, 0, 518, 476, 0, 520, 0, 476,
+	0, 0, 0, 0, 0, 88, 88, 88, 88, 88,
+	88, 88, 511, 0, 530, 0, 0, 0, 0, 88,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 62, 60, 61, 74, 0, 42, 88, 0,
+	75, 0, 0, 474, 442, 0, 0, 0, 0, 88,
+	88, 88, 88, 88, 88, 88, 88, 88, 88, 88,
+	88, 88, 88, 88, 88, 0, 0, 0, 88, 88,
+	88, 88, 88, 88, 88, 88, 88, 88, 88, 88,
+	88, 88, 0, 0, 0, 0, 0, 0, 0, 470,
+	0, 0, 168, 0, 0, 0, 0, 0, 0, 0,
+	436, 0, 0, 63, 64, 0, 0, 31, 0, 0,
+	0, 0, 0, 0, 31, 88, 0, 0, 0, 88,
+	45, 46, 38, 88, 41, 0, 0, 0, 0, 31,
+	502, 504, 505, 88, 171, 506, 507, 508, 509, 0,
+	0, 0, 0, 0, 0, 0, 88, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 190, 88, 0,
+	0, 88, 0, 0, 0, 88, 88, 526, 481, 527,
+	528, 529, 88, 88, 88, 88, 88, 88, 88, 0,
+	212, 0, 533, 0, 0, 216, 0, 0, 0, 537,
+	0, 0, 0, 539, 540, 541, 0, 0, 0, 0,
+	88, 0, 88, 0, 545, 88, 88, 0, 0, 0,
+	0, 516, 0, 0, 0, 519, 0, 0, 0, 88,
+	0, 0, 88, 0, 258, 259, 0, 261, 262, 0,
+	0, 0, 0, 0, 88, 0, 0, 88, 0, 126,
+	127, 0, 0, 0, 0, 267, 268, 269, 534, 270,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 285, 96, 0, 544, 0, 0, 195,
+	89, 88, 0, 90, 91, 0, 290, 88, 295, 0,
+	0, 0, 0, 0, 0, 0, 63, 64, 87, 0,
+	92, 308, 0, 0, 0, 316, 0, 115, 114, 116,
+	117, 118, 0, 0, 0, 0, 88, 0, 0, 194,
+	0, 0, 126, 127, 88, 0, 0, 0, 0, 0,
+	0, 0, 88, 88, 0, 88, 0, 59, 0, 0,
+	62, 60, 61, 74, 0, 42, 0, 96, 75, 0,
+	0, 0, 195, 89, 328, 0, 90, 91, 331, 332,
+	88, 0, 88, 337, 48, 49, 0, 0, 0, 63,
+	64, 87, 88, 92, 0, 0, 0, 350, 0, 0,
+	0, 0, 0, 359, 361, 362, 0, 0, 0, 365,
+	366, 367, 194, 0, 0, 0, 0, 0, 0, 0,
+	375, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 63, 64, 389, 0, 392, 393, 0, 0, 0,
+	0, 0, 44, 43, 47, 399, 0, 401, 45, 46,
+	38, 0, 41, 0, 126, 127, 0, 408, 409, 542,
+	0, 411, 412, 0, 0, 0, 413, 414, 415, 416,
+	62, 142, 143, 151, 0, 42, 0, 0, 75, 96,
+	424, 425, 0, 427, 195, 89, 430, 431, 90, 91,
+	0, 435, 0, 0, 48, 49, 440, 0, 0, 0,
+	443, 63, 64, 87, 447, 92, 0, 0, 448, 0,
+	0, 0, 0, 0, 116, 117, 118, 452, 0, 0,
+	0, 453, 0, 455, 194, 0, 457, 458, 0, 0,
+	459, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 63, 64, 471, 0, 0, 0, 0, 0, 0,
+	0, 0, 44, 43, 141, 0, 0, 479, 45, 46,
+	38, 0, 152, 0, 0, 0, 0, 0, 50, 0,
+	0, 0, 494, 495, 496, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 59, 0, 0, 62,
+	60, 61, 16, 15, 42, 0, 512, 39, 51, 18,
+	52, 21, 22, 23, 19, 20, 5, 53, 0, 54,
+	0, 58, 0, 48, 49, 0, 522, 0, 0, 0,
+	6, 33, 34, 35, 0, 0, 10, 11, 36, 0,
+	0, 0, 0, 0, 0, 0, 532, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 57, 0, 0, 55, 56, 28, 29, 0,
+	63, 64, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 44, 43, 47, 0, 0, 0, 45, 46, 38,
+	0, 41, 0, 0, 30, 0, 0, 50, 0, 0,
+	32, 62, 60, 61, 16, 15, 42, 0, 0, 39,
+	51, 18, 52, 21, 22, 23, 19, 20, 5, 53,
+	0, 54, 0, 58, 0, 48, 49, 0, 0, 0,
+	0, 0, 6, 33, 34, 35, 0, 0, 10, 11,
+	36, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 57, 0, 0, 55, 56, 28,
+	29, 0, 63, 64, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 44, 43, 47, 0, 0, 0, 45,
+	46, 38, 0, 41, 0, 0, 0, 107, 0, 50,
+	126, 127, 32, 109, 110, 108, 111, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 119, 120, 122, 123,
+	125, 124, 105, 106, 104, 96, 97, 98, 99, 94,
+	86, 89, 121, 100, 90, 91, 95, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 63, 64, 87,
+	0, 92, 93, 103, 102, 101, 112, 113, 115, 114,
+	116, 117, 118, 0, 0, 0, 0, 0, 0, 369,
+	85, 0, 83, 0, 50, 62, 60, 61, 292, 293,
+	42, 0, 0, 39, 51, 18, 52, 21, 22, 23,
+	19, 20, 5, 53, 0, 54, 0, 58, 0, 48,
+	49, 0, 0, 0, 0, 0, 6, 33, 34, 35,
+	0, 0, 10, 11, 36, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 57, 0,
+	0, 55, 56, 28, 29, 0, 63, 64, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 44, 43, 47,
+	0, 0, 0, 45, 46, 38, 0, 41, 0, 0,
+	0, 107, 0, 50, 126, 127, 32, 109, 110, 108,
+	111, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	119, 120, 122, 123, 125, 124, 105, 106, 104, 96,
+	97, 98, 99, 94, 86, 89, 121, 100, 90, 91,
+	95, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 63, 64, 87, 0, 92, 93, 103, 102, 101,
+	112, 113, 115, 114, 116, 117, 118, 0, 0, 0,
+	0, 0, 0, 0, 85, 0, 83, 107, 50, 0,
+	126, 127, 0, 109, 110, 108, 111, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 119, 120, 122, 123,
+	125, 124, 105, 106, 104, 96, 97, 98, 99, 94,
+	195, 89, 121, 100, 90, 91, 95, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 63, 64, 87,
+	0, 92, 93, 103, 102, 101, 112, 113, 115, 114,
+	116, 117, 118, 0, 0, 0, 0, 0, 0, 107,
+	194, 0, 126, 127, 297, 109, 110, 108, 111, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 119, 120,
+	122, 123, 125, 124, 105, 106, 104, 96, 97, 98,
+	99, 94, 195, 89, 121, 100, 90, 91, 95, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 63,
+	64, 87, 0, 92, 93, 103, 102, 101, 112, 113,
+	115, 114, 116, 117, 118, 0, 0, 0, 0, 0,
+	0, 107, 194, 483, 126, 127, 0, 109, 110, 108,
+	111, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	119, 120, 122, 123, 125, 124, 105, 106, 104, 96,
+	97, 98, 99, 94, 195, 89, 121, 100, 90, 91,
+	95, 0, 536, 0, 0, 0, 0, 0, 0, 0,
+	0, 63, 64, 87, 0, 92, 93, 103, 102, 101,
+	112, 113, 115, 114, 116, 117, 118, 0, 0, 107,
+	0, 0, 126, 127, 194, 109, 110, 108, 111, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 119, 120,
+	122, 123, 125, 124, 105, 106, 104, 96, 97, 98,
+	99, 94, 195, 89, 121, 100, 90, 91, 95, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 63,
+	64, 87, 0, 92, 93, 103, 102, 101, 112, 113,
+	115, 114, 116, 117, 118, 0, 0, 0, 0, 0,
+	107, 535, 194, 126, 127, 0, 109, 110, 108, 111,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
+	120, 122, 123, 125, 124, 105, 106, 104, 96, 97,
+	98, 99, 94, 195, 89, 121, 100, 90, 91, 95,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	63, 64, 87, 0, 92, 93, 103, 102, 101, 112,
+	113, 115, 114, 116, 117, 118, 0, 0, 0, 0,
+	0, 107, 517, 194, 126, 127, 0, 109, 110, 108,
+	111, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	119, 120, 122, 123, 125, 124, 105, 106, 104, 96,
+	97, 98, 99, 94, 195, 89, 121, 100, 90, 91,
+	95, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 63, 64, 87, 0, 92, 93, 103, 102, 101,
+	112, 113, 115, 114, 116, 117, 118, 0, 0, 0,
+	0, 0, 107, 437, 194, 126, 127, 0, 109, 110,
+	108, 111, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 119, 120, 122, 123, 125, 124, 105, 106, 104,
+	96, 97, 98, 99, 94, 195, 89, 121, 100, 90,
+	91, 95, 325, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 63, 64, 87, 0, 92, 93, 103, 102,
+	101, 112, 113, 115, 114, 116, 117, 118, 0, 0,
+	107, 0, 0, 126, 127, 194, 109, 110, 108, 111,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
+	120, 122, 123, 125, 124, 105, 106, 104, 96, 97,
+	98, 99, 94, 195, 89, 121, 100, 90, 91, 95,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	63, 64, 87, 0, 92, 93, 103, 102, 101, 112,
+	113, 115, 114, 116, 117, 118, 0, 0, 107, 0,
+	0, 126, 127, 194, 109, 110, 108, 111, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 119, 120, 122,
+	123, 125, 124, 105, 106, 104, 96, 97, 98, 99,
+	94, 86, 89, 121, 100, 90, 91, 95, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 63, 64,
+	87, 0, 92, 93, 103, 102, 101, 112, 113, 115,
+	114, 116, 117, 118, 0, 0, 107, 0, 0, 126,
+	127, 85, 109, 110, 108, 111, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 119, 120, 122, 123, 125,
+	124, 105, 106, 104, 96, 97, 98, 99, 94, 195,
+	89, 121, 100, 90, 91, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 63, 64, 87, 0,
+	92, 93, 103, 102, 101, 112, 113, 115, 114, 116,
+	117, 118, 0, 0, 107, 0, 0, 126, 127, 194,
+	109, 110, 108, 111, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 119, 120, 122, 123, 0, 124, 105,
+	106, 104, 96, 97, 98, 99, 0, 195, 89, 121,
+	100, 90, 91, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 63, 64, 87, 0, 92, 0,
+	103, 102, 101, 112, 113, 115, 114, 116, 117, 118,
+	0, 0, 107, 0, 0, 126, 127, 194, 109, 110,
+	108, 111, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 119, 120, 122, 123, 0, 0, 105, 106, 104,
+	96, 97, 98, 99, 0, 195, 89, 121, 100, 90,
+	91, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 63, 64, 87, 0, 92, 0, 103, 102,
+	101, 112, 113, 115, 114, 116, 117, 118, 0, 0,
+	107, 0, 0, 126, 127, 194, 109, 110, 108, 111,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
+	120, 122, 123, 0, 0, 105, 106, 104, 96, 97,
+	98, 99, 0, 195, 89, 121, 100, 90, 91, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	63, 64, 87, 0, 92, 0, 0, 102, 101, 112,
+	113, 115, 114, 116, 117, 118, 0, 0, 107, 0,
+	0, 126, 127, 194, 109, 110, 108, 111, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 119, 120, 122,
+	123, 0, 0, 105, 106, 104, 96, 97, 98, 99,
+	0, 195, 89, 121, 100, 90, 91, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 63, 64,
+	87, 0, 92, 0, 0, 0, 101, 112, 113, 115,
+	114, 116, 117, 118, 0, 0, 107, 0, 0, 126,
+	127, 194, 109, 110, 108, 111, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 119, 120, 122, 123, 0,
+	0, 105, 106, 104, 96, 97, 98, 99, 0, 195,
+	89, 121, 100, 90, 91, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 63, 64, 87, 0,
+	92, 0, 0, 0, 0, 112, 113, 115, 114, 116,
+	117, 118, 0, 0, 107, 0, 0, 126, 127, 194,
+	109, 110, 108, 111, 0, 0, 0, 0, 0, 62,
+	142, 143, 129, 0, 42, 122, 123, 75, 0, 0,
+	0, 0, 96, 97, 98, 99, 0, 195, 89, 0,
+	100, 90, 91, 136, 137, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 63, 64, 87, 0, 92, 0,
+	0, 0, 0, 112, 113, 115, 114, 116, 117, 118,
+	59, 0, 0, 62, 142, 143, 151, 194, 42, 0,
+	0, 75, 0, 0, 0, 0, 0, 0, 0, 0,
+	63, 64, 135, 134, 0, 0, 0, 48, 49, 0,
+	0, 0, 0, 0, 0, 0, 0, 45, 46, 38,
+	0, 131, 0, 0, 0, 0, 132, 50, 0, 0,
+	0, 0, 0, 0, 62, 60, 61, 74, 0, 42,
+	0, 0, 75, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 127, 0, 63, 64, 0, 0, 48, 49,
+	0, 0, 0, 0, 0, 44, 43, 141, 0, 0,
+	0, 45, 46, 38, 0, 152, 96, 97, 98, 99,
+	282, 195, 89, 0, 100, 90, 91, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 63, 64,
+	87, 0, 92, 0, 0, 63, 64, 0, 0, 115,
+	114, 116, 117, 118, 0, 0, 44, 43, 47, 0,
+	0, 194, 45, 46, 38, 0, 41, 62, 60, 61,
+	208, 263, 42, 0, 0, 39, 51, 0, 52, 0,
+	0, 0, 0, 209, 0, 0, 0, 0, 0, 0,
+	59, 48, 49, 62, 60, 61, 303, 304, 42, 0,
+	0, 75, 0, 0, 59, 0, 0, 62, 60, 61,
+	74, 0, 42, 0, 0, 75, 0, 48, 49, 59,
+	0, 0, 62, 142, 143, 406, 304, 42, 0, 0,
+	75, 48, 49, 0, 0, 0, 0, 0, 63, 64,
+	0, 0, 0, 0, 0, 0, 48, 49, 0, 44,
+	43, 47, 0, 0, 0, 45, 46, 38, 0, 41,
+	0, 0, 0, 0, 63, 64, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 44, 43, 47, 63, 64,
+	0, 45, 46, 38, 0, 41, 0, 0, 0, 44,
+	43, 47, 0, 63, 64, 45, 46, 38, 475, 41,
+	0, 0, 0, 0, 44, 43, 141, 0, 0, 0,
+	45, 46, 38, 59, 152, 0, 62, 60, 61, 74,
+	0, 42, 0, 0, 75, 0, 0, 59, 0, 0,
+	62, 142, 143, 151, 0, 42, 0, 0, 75, 0,
+	48, 49, 0, 0, 62, 60, 61, 74, 0, 42,
+	0, 0, 75, 0, 48, 49, 0, 0, 62, 142,
+	143, 151, 0, 42, 0, 0, 75, 0, 48, 49,
+	0, 0, 62, 142, 143, 151, 0, 42, 0, 0,
+	75, 0, 48, 49, 0, 0, 0, 63, 64, 0,
+	0, 0, 0, 0, 0, 0, 48, 49, 44, 43,
+	47, 63, 64, 0, 45, 46, 38, 0, 41, 0,
+	0, 0, 44, 43, 141, 63, 64, 0, 45, 46,
+	38, 0, 152, 0, 0, 0, 44, 43, 47, 63,
+	64, 0, 45, 46, 38, 0, 41, 0, 0, 0,
+	44, 43, 141, 63, 64, 0, 45, 46, 38, 0,
+	152, 0, 0, 0, 44, 43, 141, 0, 0, 0,
+	45, 46, 38, 0, 170, 62, 142, 143, 151, 0,
+	42, 0, 0, 75, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 136,
+	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 63, 64, 135, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 45, 46, 38, 0, 152,
+}
+
+var yyPact = [...]int16{
+	1042, -32768, -32768, 145, 1042, 326, 271, 1144, -32768, -32768,
+	2917, 2917, 381, 1393, -32768, 413, 2542, 318, 42, -51,
+	923, 102, -34, 75, 256, -32768, -32768, 262, 409, 408,
+	-32768, 420, 312, 406, 405, 398, -32768, -32768, -32768, 2945,
+	-32768, -32768, 392, 2917, 2917, 2917, 2917, 2917, 2917, 2917,
+	1042, 2931, 2931, 69, 67, 62, -51, 2917, -51, -32768,
+	-32768, -32768, -32768, 1042, -32768, 1042, -32768, -32768, 271, 30,
+	-32768, -32768, -32768, 1962, 333, 55, 1962, 391, 83, -32768,
+	387, 379, 377, 2740, -32768, 375, 371, -32768, -32768, 369,
+	361, 357, -32768, 2917, 2917, 2917, 2917, 2917, 2917, 2917,
+	2917, 2917, 2917, 2917, 2917, 2917, 2917, 2917, 2917, 312,
+	312, 312, 2917, 2917, 2917, 2917, 2917, 2917, 2917, 2917,
+	2917, 2917, 2917, 2917, 2917, 2917, -32768, -32768, 104, -13,
+	-32768, -32768, -32768, -76, -32768, -32768, 515, 515, -32768, 1962,
+	-32768, 2647, -25, -26, 2931, -32768, -32768, -32768, -32768, -32768,
+	-76, 139, -32768, -32768, -32768, -32768, -32768, 1144, 45, -51,
+	-32768, -32768, -32768, 76, -32768, -32768, -32768, -32768, 2596, -76,
+	-32768, 2903, 126, 771, 771, 771, 771, 771, 771, 771,
+	-55, -76, -76, -32768, 1318, -32768, 176, 1469, -32768, -67,
+	2766, -32768, -32768, 271, 351, 346, -32768, -32768, -32768, 101,
+	8, 2740, -32768, -32768, -32768, -32768, 2030, 318, 3038, 2931,
+	143, 143, 2903, -32768, -32768, -32768, 2889, 1894, 2098, 1962,
+	771, 698, 698, 698, 698, 2438, 2370, 2302, 2506, 2506,
+	2506, 2640, 2640, -24, -24, -24, 2640, 2640, 883, 883,
+	771, 771, 771, 2506, 2506, 2506, 2640, 2640, 2234, 2166,
+	2740, -32768, -32768, -32768, 2740, -32768, -32768, 2917, 2903, 355,
+	-32768, 2766, 2903, 2917, 2917, 2917, -76, 2903, 2889, 2889,
+	2889, -32768, 344, -32768, -32768, 339, 87, 87, 27, 420,
+	-74, -75, -32768, 1962, -32768, 2903, -82, 1962, -32768, -32768,
+	2889, 1219, 2542, 324, -10, 2889, 2917, -32768, -32768, -27,
+	-32768, 1962, -32768, 157, 321, -32768, -32768, -32768, 297, 2740,
+	-32768, 2740, -32768, -32768, 2030, 318, 2766, 87, 87, 27,
+	-76, -76, -84, -32768, -79, 2917, 2030, 318, 2766, 2030,
+	318, 2795, 2766, 1962, 1962, -82, -32768, 2903, -85, -84,
+	1962, 1962, 1962, 1962, 1962, 1962, 1962, 215, 43, -24,
+	2903, -32768, -32768, 312, 312, -32768, -32768, -32768, -32768, 316,
+	-32768, 314, 229, -82, -32768, 311, 310, 2903, 1962, 1144,
+	1823, 147, 2917, 1962, 1962, 305, 1042, -32768, -18, 2917,
+	-66, -82, -23, -25, -26, -32768, -28, 2030, 318, 2766,
+	2030, 318, 2766, 2766, -85, -32768, -32768, -32768, -32768, 309,
+	-32768, 159, 2098, -85, -85, 1962, 32, -85, 304, 300,
+	-32768, 299, 286, 284, 283, 276, 275, 34, 312, -51,
+	-84, -83, -24, -83, 2889, 2780, -32768, 186, -32768, -32768,
+	274, 297, -32768, -32768, -84, 270, -32768, 1144, 2917, 1541,
+	263, -45, -68, 2766, 2917, 1962, 2917, 257, 2889, -85,
+	-85, -85, 250, 2903, -32768, 2889, -32768, 248, 244, 240,
+	-69, -51, -51, -32768, -30, -51, -51, -51, -51, -24,
+	-32768, 237, 312, -92, 1962, -32768, -32768, -32768, -32768, 211,
+	1144, -32768, 1752, 2917, 1144, -32768, 2917, -29, -32768, -32768,
+	1962, 1962, -32768, 1962, 195, 189, 183, -51, -32768, -51,
+	-51, -51, -32768, 2917, -32768, -32768, -32768, -32768, -32768, -32768,
+	-32768, -24, 4, -32768, 1042, -32768, -32768, 1144, 1681, -32768,
+	1613, 1042, 156, -51, -51, -51, -32768, -32768, -32768, -32768,
+	1962, -32768, 813, -72, -32768, 1144, 1042, -32768, -30, -32768,
+	-32768, -32768, -32768, -32768, -32768, -32768,
+}
+
+var yyPgo = [...]int16{
+	0, 157, 430, 220, 582, 82, 159, 321, 457, 59,
+	455, 7, 14, 13, 1, 20, 57, 0, 453, 2,
+	450, 9, 29, 3, 443, 442, 441, 16, 440, 438,
+	43, 435, 433, 30, 4, 431, 5,
+}
+
+var yyR1 = [...]int8{
+	0, 35, 1, 1, 1, 1, 4, 4, 5, 5,
+	2, 2, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 6, 30, 30, 30, 30, 30, 30, 30,
+	30, 30, 15, 15, 7, 7, 27, 27, 27, 27,
+	27, 27, 28, 32, 32, 32, 29, 29, 29, 29,
+	31, 31, 10, 10, 24, 24, 26, 26, 26, 26,
+	26, 26, 26, 26, 26, 26, 26, 26, 36, 36,
+	25, 25, 25, 25, 25, 25, 33, 33, 33, 23,
+	23, 34, 34, 34, 8, 8, 9, 9, 9, 11,
+	11, 11, 12, 12, 16, 16, 16, 16, 16, 14,
+	14, 14, 21, 21, 13, 13, 13, 19, 19, 20,
+	20, 20, 20, 20, 17, 17, 17, 17, 17, 17,
+	17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
+	17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
+	17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
+	17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
+	17, 17, 17, 17, 17, 17, 17, 17, 17, 17,
+	17, 17, 17, 17, 17, 17, 17, 22, 22, 18,
+	18, 18, 18, 18,
+}
+
+var yyR2 = [...]int8{
+	0, 1, 0, 1, 3, 2, 0, 2, 1, 2,
+	1, 1, 2, 3, 2, 1, 2, 1, 1, 1,
+	2, 2, 3, 5, 3, 5, 4, 5, 5, 4,
+	1, 1, 2, 4, 4, 3, 4, 4, 3, 1,
+	2, 2, 2, 2, 2, 2, 7, 7, 2, 7,
+	7, 7, 1, 1, 4, 1, 1, 1, 2, 1,
+	2, 2, 3, 2, 2, 2, 2, 2, 4, 4,
+	3, 2, 1, 4, 3, 6, 5, 6, 8, 9,
+	7, 4, 6, 0, 5, 4, 2, 7, 6, 3,
+	1, 3, 1, 2, 2, 7, 8, 8, 8, 9,
+	9, 9, 7, 8, 8, 7, 8, 7, 1, 1,
+	4, 5, 4, 5, 4, 5, 0, 3, 3, 1,
+	3, 5, 6, 6, 7, 3, 1, 1, 3, 0,
+	1, 4, 1, 4, 3, 3, 3, 7, 3, 0,
+	1, 4, 1, 1, 0, 1, 4, 1, 1, 2,
+	4, 2, 4, 3, 1, 5, 6, 5, 5, 6,
+	6, 6, 6, 1, 2, 5, 3, 3, 3, 3,
+	3, 6, 7, 2, 2, 2, 2, 2, 2, 2,
+	5, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 2, 2, 2, 2, 1, 3, 1,
+	1, 1, 1, 5,
+}
+
+var yyChk = [...]int16{
+	-32768, -35, -1, -3, -2, 24, 38, -24, -25, -26,
+	44, 45, -10, -17, -6, 11, 10, -30, 17, 22,
+	23, 19, 20, 21, -8, -27, -28, -29, 75, 76,
+	102, -5, 108, 39, 40, 41, 46, -18, 97, 15,
+	-7, 99, 12, 90, 89, 95, 96, 91, 31, 32,
+	105, 16, 18, 25, 27, 73, 74, 70, 29, 4,
+	8, 9, 7, 78, 79, -2, -1, -9, 46, 10,
+	91, -9, -3, -17, 10, 15, -17, 11, 10, 46,
+	39, 40, 41, 103, -6, 101, 61, 80, -7, 62,
+	65, 66, 82, 83, 60, 67, 56, 57, 58, 59,
+	64, 86, 85, 84, 55, 53, 54, 28, 36, 34,
+	35, 37, 87, 88, 90, 89, 91, 92, 93, 47,
+	48, 63, 49, 50, 52, 51, 31, 32, 10, 10,
+	-6, 99, 104, -15, 81, 80, 31, 32, -21, -17,
+	-16, 91, 8, 9, 10, 13, 14, -6, -6, -6,
+	-15, 10, 99, 99, 99, -6, 99, 26, 30, 77,
+	10, 10, 4, -22, 10, 10, 10, 10, -4, -15,
+	99, -4, 10, -17, -17, -17, -17, -17, -17, -17,
+	-1, -15, -15, 99, 99, 99, -6, -17, -6, -1,
+	-4, -1, -9, 101, 101, 61, 31, 32, 99, 10,
+	10, 103, 81, 10, 10, 10, -17, -30, 10, 23,
+	10, 10, -4, 10, 10, 10, -4, -17, -17, -17,
+	-17, -17, -17, -17, -17, -17, -17, -17, -17, -17,
+	-17, -17, -17, -22, -22, -22, -17, -17, -17, -17,
+	-17, -17, -17, -17, -17, -17, -17, -17, -17, -17,
+	103, -36, 99, 80, 103, 80, 81, 104, -4, -4,
+	107, -4, -4, 104, 104, 104, -15, -4, -4, -4,
+	-4, -3, 99, -6, 80, 101, -33, -33, -33, -5,
+	-11, -12, 104, -17, -16, -4, -12, -17, 80, 106,
+	-4, -17, 10, 11, -3, -4, 73, 105, 106, -13,
+	-19, -17, -20, 10, 11, -9, 10, 10, -4, 103,
+	-36, 103, 80, 81, -17, -30, -4, -33, -33, -33,
+	-15, -15, -14, -21, -11, 68, -17, -30, -4, -17,
+	-30, -4, -4, -17, -17, -12, -27, -4, -13, -14,
+	-17, -17, -17, -17, -17, -17, -17, -31, 10, -22,
+	-4, 10, -6, 42, 43, -6, -34, 105, 107, -4,
+	107, -4, -4, -12, 107, -4, -4, -4, -17, 100,
+	-17, 10, 102, -17, -17, -4, 33, 107, 10, 103,
+	10, -12, 10, 8, 9, 99, 91, -17, -30, -4,
+	-17, -30, -4, -4, -13, -6, -6, -34, 107, -4,
+	107, -4, -17, -13, -13, -17, 10, -13, -4, -4,
+	-21, -4, -4, -4, -4, -4, -4, 10, 84, 100,
+	-14, -23, -22, -23, -4, -4, 98, -4, 98, 98,
+	-4, -4, 100, 100, -14, -4, -3, 100, 28, -17,
+	-4, -32, -1, -4, 103, -17, 103, -4, -4, -13,
+	-13, -13, -4, -4, 100, -4, 98, -4, -4, -4,
+	100, 100, 100, 100, 100, 100, 100, 100, 100, -22,
+	-6, -4, 107, -11, -17, 98, -16, 98, 100, -4,
+	100, -3, -17, 102, 100, 106, 71, 72, 106, -19,
+	-17, -17, 100, -17, -4, -4, -4, 100, -21, 100,
+	100, 100, -6, 104, -6, -6, -6, -6, -6, -6,
+	100, -22, -4, 107, 102, 100, -3, 100, -17, -3,
+	-17, 104, -4, 100, 100, 100, -6, -6, -6, -6,
+	-17, 106, -4, -1, -3, 100, 69, -1, 100, -6,
+	-6, -6, 106, 106, -3, -1,
+}
+
+var yyDef = [...]int16{
+	2, -2, 1, 3, 2, 0, 0, 15, 17, 18,
+	19, 0, 0, 30, 31, 0, 222, 39, 0, 0,
+	0, 0, 0, 0, 52, 53, 55, 56, 57, 59,
+	10, 11, 0, 0, 0, 0, 92, 154, 6, 0,
+	163, 6, 0, 0, 0, 0, 0, 0, 0, 0,
+	2, 0, 0, 0, 0, 0, 0, 0, 0, 8,
+	219, 220, 221, 2, 6, 2, 5, 12, 0, 126,
+	127, 14, 16, 20, 222, 0, 21, 0, 0, 93,
+	0, 0, 0, 0, 61, 0, 0, 6, 164, 0,
+	0, 0, 6, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 215, 216, 32, 222,
+	43, 6, 6, 63, 6, 6, 213, 214, 72, 142,
+	143, 0, 219, 220, 71, 40, 41, 42, 44, 45,
+	67, 222, 6, 6, 6, 48, 6, 0, 0, 0,
+	58, 60, 9, 94, 217, 116, 116, 116, 129, 64,
+	6, 0, 0, 173, 174, 175, 176, 177, 178, 179,
+	0, 65, 66, 6, 0, 6, 0, 0, 86, 0,
+	144, 4, 13, 0, 0, 0, 213, 214, 6, 22,
+	24, 0, 6, 116, 116, 116, 35, 38, 222, 0,
+	166, 167, 139, 168, 169, 170, 129, 0, 181, 182,
+	183, 184, 185, 186, 187, 188, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
+	0, 6, 108, 109, 0, 6, 6, 0, 0, 0,
+	6, 144, 139, 0, 0, 0, 70, 0, 0, 0,
+	0, 125, 0, 89, 6, 0, 0, 0, 0, 7,
+	6, 6, 6, 130, 132, 0, 6, 6, 6, 62,
+	0, 30, 222, 0, 0, 0, 0, 6, 74, 0,
+	145, 147, 148, 222, 0, 128, 166, 167, 0, 0,
+	6, 0, 6, 6, 26, 29, 144, 0, 0, 0,
+	68, 69, 6, 140, 6, 0, 33, 36, 144, 34,
+	37, 139, 144, 134, 6, 6, 54, 0, 6, 6,
+	138, 135, 136, 6, 6, 6, 6, 0, 217, 90,
+	139, 218, 110, 0, 0, 112, 114, 6, 6, 0,
+	6, 0, 0, 6, 6, 0, 0, 139, 6, 0,
+	142, 32, 0, 6, 81, 83, 2, 6, 151, 0,
+	149, 6, 0, 0, 0, 6, 0, 23, 27, 144,
+	25, 28, 144, 144, 6, 111, 113, 115, 6, 0,
+	6, 0, 180, 6, 6, 142, 222, 6, 0, 0,
+	73, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	6, 117, 119, 118, 129, 0, 155, 0, 157, 158,
+	0, 0, 165, 223, 6, 0, 76, 0, 0, 0,
+	0, 0, 0, 0, 0, 153, 0, 0, 0, 6,
+	6, 6, 0, 0, 161, 0, 171, 0, 0, 0,
+	223, 165, 0, 160, 223, 0, 0, 0, 0, 91,
+	88, 0, 0, 6, 131, 156, 133, 159, -2, 0,
+	0, 77, 0, 0, 0, 82, 0, 0, 75, 146,
+	152, 150, 162, 6, 0, 0, 0, 0, 141, 0,
+	105, 107, 50, 0, 51, 102, 46, 47, 49, 87,
+	95, 120, 0, 6, 2, 172, 124, 0, 0, 80,
+	0, 2, 0, 0, 104, 106, 103, 96, 97, 98,
+	137, 121, 0, 0, 78, 0, 2, 85, 0, 99,
+	100, 101, 122, 123, 79, 84,
+}
+
+var yyTok1 = [...]int8{
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 95, 3, 3, 3, 93, 86, 3,
+	99, 100, 91, 89, 107, 90, 101, 92, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 104, 102,
+	87, 103, 88, 83, 108, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 97, 3, 98, 85, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 105, 84, 106, 96,
+}
+
+var yyTok2 = [...]int8{
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
+	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+	82, 94,
+}
+
+var yyTok3 = [...]int8{
+	0,
+}
+
+var yyErrorMessages = [...]struct {
+	state int
+	token int
+	msg   string
+}{}
+
+//line yaccpar:1
+
+/*	parser for yacc output	*/
+
+var (
+	yyDebug        = 0
+	yyErrorVerbose = false
+)
+
+type yyLexer interface {
+	Lex(lval *yySymType) int
+	Error(s string)
+}
+
+type yyParser interface {
+	Parse(yyLexer) int
+	Lookahead() int
+}
+
+type yyParserImpl struct {
+	lval  yySymType
+	stack [yyInitialStackSize]yySymType
+	char  int
+}
+
+func (p *yyParserImpl) Lookahead() int {
+	return p.char
+}
+
+func yyNewParser() yyParser {
+	return &yyParserImpl{}
+}
+
+const yyFlag = -32768
+
+func yyTokname(c int) string {
+	if c >= 1 && c-1 < len(yyToknames) {
+		if yyToknames[c-1] != "" {
+			return yyToknames[c-1]
+		}
+	}
+	return __yyfmt__.Sprintf("tok-%v", c)
+}
+
+func yyStatname(s int) string {
+	if s >= 0 && s < len(yyStatenames) {
+		if yyStatenames[s] != "" {
+			return yyStatenames[s]
+		}
+	}
+	return __yyfmt__.Sprintf("state-%v", s)
+}
+
+func yyErrorMessage(state, lookAhead int) string {
+	const TOKSTART = 4
+
+	if !yyErrorVerbose {
+		return "syntax error"
+	}
+
+	for _, e := range yyErrorMessages {
+		if e.state == state && e.token == lookAhead {
+			return "syntax error: " + e.msg
+		}
+	}
+
+	res := "syntax error: unexpected " + yyTokname(lookAhead)
+
+	// To match Bison, suggest at most four expected tokens.
+	expected := make([]int, 0, 4)
+
+	// Look for shiftable tokens.
+	base := int(yyPact[state])
+	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+	}
+
+	if yyDef[state] == -2 {
+		i := 0
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; yyExca[i] >= 0; i += 2 {
+			tok := int(yyExca[i])
+			if tok < TOKSTART || yyExca[i+1] == 0 {
+				continue
+			}
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if yyExca[i+1] != 0 {
+			return res
+		}
+	}
+
+	for i, tok := range expected {
+		if i == 0 {
+			res += ", expecting "
+		} else {
+			res += " or "
+		}
+		res += yyTokname(tok)
+	}
+	return res
+}
+
+func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
+	if char <= 0 {
+		token = int(yyTok1[0])
+		goto out
+	}
+	if char < len(yyTok1) {
+		token = int(yyTok1[char])
+		goto out
+	}
+	if char >= yyPrivate {
+		if char < yyPrivate+len(yyTok2) {
+			token = int(yyTok2[char-yyPrivate])
+			goto out
+		}
+	}
+	for i := 0; i < len(yyTok3); i += 2 {
+		token = int(yyTok3[i+0])
+		if token == char {
+			token = int(yyTok3[i+1])
+			goto out
+		}
+	}
+
+out:
+	if token == 0 {
+		token = int(yyTok2[1]) /* unknown char */
+	}
+	if yyDebug >= 3 {
+		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
+	}
+	return char, token
+}
+
+func yyParse(yylex yyLexer) int {
+	return yyNewParser().Parse(yylex)
+}
+
+func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
+	var yyn int
+	var yyVAL yySymType
+	var yyDollar []yySymType
+	_ = yyDollar // silence set and not used
+	yyS := yyrcvr.stack[:]
+
+	Nerrs := 0   /* number of errors */
+	Errflag := 0 /* error recovery flag */
+	yystate := 0
+	yyrcvr.char = -1
+	yytoken := -1 // yyrcvr.char translated into internal numbering
+	defer func() {
+		// Make sure we report no lookahead when not parsing.
+		yystate = -1
+		yyrcvr.char = -1
+		yytoken = -1
+	}()
+	yyp := -1
+	goto yystack
+
+ret0:
+	return 0
+
+ret1:
+	return 1
+
+yystack:
+	/* put a state and value onto the stack */
+	if yyDebug >= 4 {
+		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
+	}
+
+	yyp++
+	if yyp >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyS[yyp] = yyVAL
+	yyS[yyp].yys = yystate
+
+yynewstate:
+	yyn = int(yyPact[yystate])
+	if yyn <= yyFlag {
+		goto yydefault /* simple state */
+	}
+	if yyrcvr.char < 0 {
+		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+	}
+	yyn += yytoken
+	if yyn < 0 || yyn >= yyLast {
+		goto yydefault
+	}
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
+		yyrcvr.char = -1
+		yytoken = -1
+		yyVAL = yyrcvr.lval
+		yystate = yyn
+		if Errflag > 0 {
+			Errflag--
+		}
+		goto yystack
+	}
+
+yydefault:
+	/* default state action */
+	yyn = int(yyDef[yystate])
+	if yyn == -2 {
+		if yyrcvr.char < 0 {
+			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+		}
+
+		/* look through exception table */
+		xi := 0
+		for {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
+				break
+			}
+			xi += 2
+		}
+		for xi += 2; ; xi += 2 {
+			yyn = int(yyExca[xi+0])
+			if yyn < 0 || yyn == yytoken {
+				break
+			}
+		}
+		yyn = int(yyExca[xi+1])
+		if yyn < 0 {
+			goto ret0
+		}
+	}
+	if yyn == 0 {
+		/* error ... attempt to resume parsing */
+		switch Errflag {
+		case 0: /* brand new error */
+			yylex.Error(yyErrorMessage(yystate, yytoken))
+			Nerrs++
+			if yyDebug >= 1 {
+				__yyfmt__.Printf("%s", yyStatname(yystate))
+				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
+			}
+			fallthrough
+
+		case 1, 2: /* incompletely recovered error ... try again */
+			Errflag = 3
+
+			/* find a state where "error" is a legal shift action */
+			for yyp >= 0 {
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
+				if yyn >= 0 && yyn < yyLast {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
+						goto yystack
+					}
+				}
+
+				/* the current p has no shift on "error", pop stack */
+				if yyDebug >= 2 {
+					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
+				}
+				yyp--
+			}
+			/* there is no state on the stack with an error shift ... abort */
+			goto ret1
+
+		case 3: /* no shift yet; clobber input char */
+			if yyDebug >= 2 {
+				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
+			}
+			if yytoken == yyEofCode {
+				goto ret1
+			}
+			yyrcvr.char = -1
+			yytoken = -1
+			goto yynewstate /* try again in the same state */
+		}
+	}
+
+	/* reduction by production yyn */
+	if yyDebug >= 2 {
+		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
+	}
+
+	yynt := yyn
+	yypt := yyp
+	_ = yypt // guard against "declared and not used"
+
+	yyp -= int(yyR2[yyn])
+	// yyp is now the index of $0. Perform the default action. Iff the
+	// reduced production is ε, $1 is possibly out of range.
+	if yyp+1 >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyVAL = yyS[yyp+1]
+
+	/* consult goto table to find next state */
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
+	yyj := yyg + yyS[yyp].yys + 1
+
+	if yyj >= yyLast {
+		yystate = int(yyAct[yyg])
+	} else {
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
+		}
+	}
+	// dummy call; replaced with literal code
+	switch yynt {
+
+	case 1:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:104
+		{
+			yylex.(*LexerWrapper).file = &File{Stmts: yyDollar[1].stmts}
+		}
+	case 2:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:108
+		{
+			yyVAL.stmts = nil
+		}
+	case 3:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:109
+		{
+			yyVAL.stmts = []Stmt{yyDollar[1].stmt}
+		}
+	case 4:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:110
+		{
+			yyVAL.stmts = append(append([]Stmt{yyDollar[1].stmt}, yyDollar[2].stmts...), yyDollar[3].stmts...)
+		}
+	case 5:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:111
+		{
+			yyVAL.stmts = append(yyDollar[1].stmts, yyDollar[2].stmts...)
+		}
+	case 6:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:113
+		{
+			yyVAL.breaks = nil
+		}
+	case 7:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:114
+		{
+			yyVAL.breaks = append(yyDollar[1].breaks, yyDollar[2].breaks...)
+		}
+	case 8:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:117
+		{
+			yyVAL.breaks = []*LineBreak{{Comment: yyDollar[1].str, Inline: yyDollar[1].comments}}
+		}
+	case 9:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:118
+		{
+			yyVAL.breaks = append(yyDollar[1].breaks, &LineBreak{Comment: yyDollar[2].str, Inline: yyDollar[2].comments})
+		}
+	case 10:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:120
+		{
+			yyVAL.stmts = []Stmt{&Semicolon{}}
+		}
+	case 11:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:121
+		{
+			yyVAL.stmts = lineBreakStmts(yyDollar[1].breaks)
+		}
+	case 12:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:124
+		{
+			yyVAL.stmt = &ImportStmt{Path: yyDollar[2].str}
+		}
+	case 13:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:125
+		{
+			yyVAL.stmt = &ImportStmt{Static: true, Path: yyDollar[3].str}
+		}
+	case 14:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:126
+		{
+			yyVAL.stmt = &PackageStmt{Path: yyDollar[2].str}
+		}
+	case 15:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:127
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 16:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:129
+		{
+			yyDollar[1].stmt.(*Annotation).Stmt = yyDollar[2].stmt
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 17:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:130
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 18:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:131
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 19:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:132
+		{
+			yyVAL.stmt = &ReturnStmt{}
+		}
+	case 20:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:133
+		{
+			yyVAL.stmt = &ReturnStmt{X: yyDollar[2].expr}
+		}
+	case 21:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:134
+		{
+			yyVAL.stmt = &ThrowStmt{X: yyDollar[2].expr}
+		}
+	case 22:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:135
+		{
+			yyVAL.stmt = &DeclStmt{Modifiers: yyDollar[1].str, Type: yyDollar[2].str, Name: yyDollar[3].str}
+		}
+	case 23:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:136
+		{
+			yyVAL.stmt = &DeclStmt{Modifiers: yyDollar[1].str, Type: yyDollar[2].str, Name: yyDollar[3].str, Value: yyDollar[5].expr}
+		}
+	case 24:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:137
+		{
+			yyVAL.stmt = &DeclStmt{Modifiers: yyDollar[1].str, Type: yyDollar[2].str, Name: yyDollar[3].str}
+		}
+	case 25:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:138
+		{
+			yyVAL.stmt = &DeclStmt{Modifiers: yyDollar[1].str, Type: yyDollar[2].str, Name: yyDollar[3].str, Value: yyDollar[5].expr}
+		}
+	case 26:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:139
+		{
+			yyVAL.stmt = &DeclStmt{Modifiers: yyDollar[1].str, Name: yyDollar[2].str, Value: yyDollar[4].expr}
+		}
+	case 27:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:141
+		{
+			yyVAL.stmt = &DeclStmt{Modifiers: yyDollar[1].str, Type: yyDollar[2].str, Name: yyDollar[3].str, Value: &CommandExpr{Cmd: yyDollar[5].command}}
+		}
+	case 28:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:142
+		{
+			yyVAL.stmt = &DeclStmt{Modifiers: yyDollar[1].str, Type: yyDollar[2].str, Name: yyDollar[3].str, Value: &CommandExpr{Cmd: yyDollar[5].command}}
+		}
+	case 29:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:143
+		{
+			yyVAL.stmt = &DeclStmt{Modifiers: yyDollar[1].str, Name: yyDollar[2].str, Value: &CommandExpr{Cmd: yyDollar[4].command}}
+		}
+	case 30:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:145
+		{
+			yyVAL.stmt = &ExprStmt{X: yyDollar[1].expr}
+		}
+	case 31:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:146
+		{
+			yyVAL.stmt = yyDollar[1].block
+		}
+	case 32:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:148
+		{
+			yyVAL.stmt = &DeclStmt{Type: yyDollar[1].str, Name: yyDollar[2].str}
+		}
+	case 33:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:150
+		{
+			yyVAL.stmt = &DeclStmt{Type: yyDollar[1].str, Name: yyDollar[2].str, Value: yyDollar[4].expr}
+		}
+	case 34:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:151
+		{
+			yyVAL.stmt = &DeclStmt{Type: yyDollar[1].str, Name: yyDollar[2].str, Value: yyDollar[4].expr}
+		}
+	case 35:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:152
+		{
+			yyVAL.stmt = &AssignStmt{Lhs: yyDollar[1].expr, Rhs: yyDollar[3].expr}
+		}
+	case 36:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:153
+		{
+			yyVAL.stmt = &DeclStmt{Type: yyDollar[1].str, Name: yyDollar[2].str, Value: &CommandExpr{Cmd: yyDollar[4].command}}
+		}
+	case 37:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:154
+		{
+			yyVAL.stmt = &DeclStmt{Type: yyDollar[1].str, Name: yyDollar[2].str, Value: &CommandExpr{Cmd: yyDollar[4].command}}
+		}
+	case 38:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:155
+		{
+			yyVAL.stmt = &AssignStmt{Lhs: yyDollar[1].expr, Rhs: &CommandExpr{Cmd: yyDollar[3].command}}
+		}
+	case 39:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:157
+		{
+			yyVAL.stmt = newCommand(yyDollar[1].command)
+		}
+	case 40:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:158
+		{
+			yyVAL.stmt = &CommandStmt{Name: yyDollar[1].str, Args: newExprList(&Ident{Name: yyDollar[2].str, Comments: yyDollar[2].comments})}
+		}
+	case 41:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:159
+		{
+			yyVAL.stmt = &CommandStmt{Name: yyDollar[1].str, Args: newExprList(&Ident{Name: yyDollar[2].str, Comments: yyDollar[2].comments})}
+		}
+	case 42:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:160
+		{
+			yyVAL.stmt = &BlockCallStmt{Fun: &Ident{Name: yyDollar[1].str}, Body: yyDollar[2].block}
+		}
+	case 43:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:162
+		{
+			yyVAL.stmt = &BlockCallStmt{Fun: &Ident{Name: yyDollar[1].str}, Body: yyDollar[2].block}
+		}
+	case 44:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:163
+		{
+			yyVAL.stmt = &BlockCallStmt{Fun: &Ident{Name: yyDollar[1].str}, Body: yyDollar[2].block}
+		}
+	case 45:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:165
+		{
+			yyVAL.stmt = &BlockCallStmt{Fun: &Ident{Name: yyDollar[1].str}, Body: yyDollar[2].block}
+		}
+	case 46:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:166
+		{
+			yyVAL.stmt = &BlockCallStmt{Fun: &Ident{Name: yyDollar[1].str}, Args: newExprList(yyDollar[4].expr).enclose(yyDollar[3].breaks, yyDollar[5].breaks), Body: yyDollar[7].block}
+		}
+	case 47:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:167
+		{
+			yyVAL.stmt = &BlockCallStmt{Fun: &Ident{Name: yyDollar[1].str}, Args: newExprList(yyDollar[4].expr).enclose(yyDollar[3].breaks, yyDollar[5].breaks), Body: yyDollar[7].block}
+		}
+	case 48:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:168
+		{
+			yyVAL.stmt = &BlockCallStmt{Fun: &Ident{Name: yyDollar[1].str}, Body: yyDollar[2].block}
+		}
+	case 49:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:169
+		{
+			yyVAL.stmt = &BlockCallStmt{Fun: &Ident{Name: yyDollar[1].str}, Args: newExprList(yyDollar[4].expr).enclose(yyDollar[3].breaks, yyDollar[5].breaks), Body: yyDollar[7].block}
+		}
+	case 50:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:171
+		{
+			yyVAL.stmt = &BlockCallStmt{Fun: &Ident{Name: yyDollar[1].str}, Args: newExprList(yyDollar[4].expr).enclose(yyDollar[3].breaks, yyDollar[5].breaks), Body: yyDollar[7].block}
+		}
+	case 51:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:173
+		{
+			yyVAL.stmt = &BlockCallStmt{Fun: &Ident{Name: yyDollar[1].str}, Args: yyDollar[4].list.enclose(yyDollar[3].breaks, yyDollar[5].breaks), Body: yyDollar[7].block}
+		}
+	case 52:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:174
+		{
+			yyVAL.stmt = yyDollar[1].ifstmt
+		}
+	case 53:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:175
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 54:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:177
+		{
+			yyVAL.stmt = &LabeledStmt{Label: yyDollar[1].str, Breaks: yyDollar[3].breaks, Stmt: yyDollar[4].stmt}
+		}
+	case 55:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:178
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 56:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:179
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 57:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:180
+		{
+			yyVAL.stmt = &BranchStmt{Tok: yyDollar[1].str}
+		}
+	case 58:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:181
+		{
+			yyVAL.stmt = &BranchStmt{Tok: yyDollar[1].str, Label: yyDollar[2].str}
+		}
+	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:182
+		{
+			yyVAL.stmt = &BranchStmt{Tok: yyDollar[1].str}
+		}
+	case 60:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:183
+		{
+			yyVAL.stmt = &BranchStmt{Tok: yyDollar[1].str, Label: yyDollar[2].str}
+		}
+	case 61:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:184
+		{
+			yyVAL.stmt = &BlockCallStmt{Fun: yyDollar[1].expr, Body: yyDollar[2].block}
+		}
+	case 62:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:186
+		{
+			yyVAL.block = &Block{Stmts: yyDollar[2].stmts, Closing: yyDollar[3].comments}
+		}
+	case 63:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:189
+		{
+			yyVAL.command = &CommandStmt{Name: yyDollar[1].str, Args: yyDollar[2].list}
+		}
+	case 64:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:190
+		{
+			yyVAL.command = &CommandStmt{Name: yyDollar[1].str, Args: yyDollar[2].list}
+		}
+	case 65:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:191
+		{
+			yyVAL.command = &CommandStmt{Name: yyDollar[1].str, Args: yyDollar[2].list}
+		}
+	case 66:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:192
+		{
+			yyVAL.command = &CommandStmt{Name: yyDollar[1].str, Args: yyDollar[2].list}
+		}
+	case 67:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:193
+		{
+			yyVAL.command = &CommandStmt{Name: yyDollar[1].str, Args: yyDollar[2].list}
+		}
+	case 68:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:195
+		{
+			yyVAL.command = &CommandStmt{Fun: &SelectorExpr{X: yyDollar[1].expr, Op: ".", Sel: yyDollar[3].str}, Args: yyDollar[4].list}
+		}
+	case 69:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:196
+		{
+			yyVAL.command = &CommandStmt{Fun: &SelectorExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Sel: yyDollar[3].str}, Args: yyDollar[4].list}
+		}
+	case 70:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:197
+		{
+			yyVAL.command = &CommandStmt{Recv: yyDollar[1].command, Name: yyDollar[2].str, Args: yyDollar[3].list}
+		}
+	case 71:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:198
+		{
+			yyVAL.command = &CommandStmt{Recv: yyDollar[1].command, Name: yyDollar[2].str}
+		}
+	case 72:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:200
+		{
+			yyVAL.list = newExprList(yyDollar[1].expr)
+		}
+	case 73:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:201
+		{
+			yyVAL.list = yyDollar[1].list.append(yyDollar[3].breaks, yyDollar[4].expr)
+		}
+	case 74:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:203
+		{
+			yyVAL.block = &Block{Stmts: yyDollar[2].stmts, Closing: yyDollar[3].comments}
+		}
+	case 75:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:204
+		{
+			yyVAL.block = &Block{Params: yyDollar[3].list.enclose(yyDollar[2].breaks, nil), Stmts: yyDollar[5].stmts, Closing: yyDollar[6].comments}
+		}
+	case 76:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:209
+		{
+			forIn := newForIn("", yyDollar[3].expr, yyDollar[5].stmt)
+			if forIn == nil {
+				yylex.Error("syntax error: expecting `for (x in xs)`")
+				goto ret1
+			}
+			yyVAL.stmt = forIn
+		}
+	case 77:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:219
+		{
+			forIn := newForIn(yyDollar[3].str, yyDollar[4].expr, yyDollar[6].stmt)
+			if forIn == nil {
+				yylex.Error("syntax error: expecting `for (Type x in xs)`")
+				goto ret1
+			}
+			yyVAL.stmt = forIn
+		}
+	case 78:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:227
+		{
+			yyVAL.stmt = &ForInStmt{Type: yyDollar[3].str, Var: yyDollar[4].str, X: yyDollar[6].expr, Body: yyDollar[8].stmt}
+		}
+	case 79:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:228
+		{
+			yyVAL.stmt = &ForStmt{Init: yyDollar[3].stmt, Cond: yyDollar[5].expr, Post: yyDollar[7].expr, Body: yyDollar[9].stmt}
+		}
+	case 80:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:229
+		{
+			yyVAL.stmt = &WhileStmt{Cond: &ParenExpr{X: yyDollar[4].expr, Lparen: yyDollar[3].breaks, Rparen: yyDollar[5].breaks}, Body: yyDollar[7].stmt}
+		}
+	case 81:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:230
+		{
+			yyVAL.stmt = &DoWhileStmt{Body: yyDollar[2].block, Cond: yyDollar[4].expr}
+		}
+	case 82:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:232
+		{
+			yyVAL.stmt = &SwitchStmt{Tag: yyDollar[2].expr, Breaks: yyDollar[4].breaks, Cases: yyDollar[5].cases}
+		}
+	case 83:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:234
+		{
+			yyVAL.cases = nil
+		}
+	case 84:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:235
+		{
+			yyVAL.cases = append(yyDollar[1].cases, &CaseClause{Value: yyDollar[3].expr, Body: yyDollar[5].stmts})
+		}
+	case 85:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:236
+		{
+			yyVAL.cases = append(yyDollar[1].cases, &CaseClause{Body: yyDollar[4].stmts})
+		}
+	case 86:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:238
+		{
+			yyVAL.stmt = &TryStmt{Body: yyDollar[2].block}
+		}
+	case 87:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:239
+		{
+			yyDollar[1].stmt.(*TryStmt).Catches = append(yyDollar[1].stmt.(*TryStmt).Catches, &CatchClause{Types: yyDollar[4].strs, Name: yyDollar[5].str, Body: yyDollar[7].block})
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 88:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:240
+		{
+			yyDollar[1].stmt.(*TryStmt).Catches = append(yyDollar[1].stmt.(*TryStmt).Catches, &CatchClause{Name: yyDollar[4].str, Body: yyDollar[6].block})
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 89:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:241
+		{
+			yyDollar[1].stmt.(*TryStmt).Finally = yyDollar[3].block
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 90:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:244
+		{
+			yyVAL.strs = []string{yyDollar[1].str}
+		}
+	case 91:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:245
+		{
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
+		}
+	case 92:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:247
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 93:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:248
+		{
+			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
+		}
+	case 94:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:250
+		{
+			yyVAL.stmt = &Annotation{Name: yyDollar[2].str}
+		}
+	case 95:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:251
+		{
+			yyVAL.stmt = &Annotation{Name: yyDollar[2].str, Args: yyDollar[5].list.enclose(yyDollar[4].breaks, yyDollar[6].breaks)}
+		}
+	case 96:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:254
+		{
+			yyVAL.stmt = &FuncDecl{Type: yyDollar[1].str, Name: yyDollar[2].str, Params: yyDollar[5].list.enclose(yyDollar[4].breaks, yyDollar[6].breaks), Body: yyDollar[8].block}
+		}
+	case 97:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:255
+		{
+			yyVAL.stmt = &FuncDecl{Type: yyDollar[1].str, Name: yyDollar[2].str, Params: yyDollar[5].list.enclose(yyDollar[4].breaks, yyDollar[6].breaks), Body: yyDollar[8].block}
+		}
+	case 98:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:256
+		{
+			yyVAL.stmt = &FuncDecl{Type: yyDollar[1].str, Name: yyDollar[2].str, Params: yyDollar[5].list.enclose(yyDollar[4].breaks, yyDollar[6].breaks), Body: yyDollar[8].block}
+		}
+	case 99:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:257
+		{
+			yyVAL.stmt = &FuncDecl{Modifiers: yyDollar[1].str, Type: yyDollar[2].str, Name: yyDollar[3].str, Params: yyDollar[6].list.enclose(yyDollar[5].breaks, yyDollar[7].breaks), Body: yyDollar[9].block}
+		}
+	case 100:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:258
+		{
+			yyVAL.stmt = &FuncDecl{Modifiers: yyDollar[1].str, Type: yyDollar[2].str, Name: yyDollar[3].str, Params: yyDollar[6].list.enclose(yyDollar[5].breaks, yyDollar[7].breaks), Body: yyDollar[9].block}
+		}
+	case 101:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:259
+		{
+			yyVAL.stmt = &FuncDecl{Modifiers: yyDollar[1].str, Type: yyDollar[2].str, Name: yyDollar[3].str, Params: yyDollar[6].list.enclose(yyDollar[5].breaks, yyDollar[7].breaks), Body: yyDollar[9].block}
+		}
+	case 102:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:261
+		{
+			yyVAL.stmt = &FuncDecl{Name: yyDollar[1].str, Params: yyDollar[4].list.enclose(yyDollar[3].breaks, yyDollar[5].breaks), Body: yyDollar[7].block}
+		}
+	case 103:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:262
+		{
+			yyVAL.stmt = &FuncDecl{Modifiers: yyDollar[1].str, Name: yyDollar[2].str, Params: yyDollar[5].list.enclose(yyDollar[4].breaks, yyDollar[6].breaks), Body: yyDollar[8].block}
+		}
+	case 104:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:263
+		{
+			yyVAL.stmt = &FuncDecl{Modifiers: yyDollar[1].str, Type: yyDollar[2].str, Name: yyDollar[3].str, Params: yyDollar[6].list.enclose(yyDollar[5].breaks, yyDollar[7].breaks)}
+		}
+	case 105:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:264
+		{
+			yyVAL.stmt = &FuncDecl{Type: yyDollar[1].str, Name: yyDollar[2].str, Params: yyDollar[5].list.enclose(yyDollar[4].breaks, yyDollar[6].breaks)}
+		}
+	case 106:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:265
+		{
+			yyVAL.stmt = &FuncDecl{Modifiers: yyDollar[1].str, Type: yyDollar[2].str, Name: yyDollar[3].str, Params: yyDollar[6].list.enclose(yyDollar[5].breaks, yyDollar[7].breaks)}
+		}
+	case 107:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:266
+		{
+			yyVAL.stmt = &FuncDecl{Type: yyDollar[1].str, Name: yyDollar[2].str, Params: yyDollar[5].list.enclose(yyDollar[4].breaks, yyDollar[6].breaks)}
+		}
+	case 110:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:271
+		{
+			yyVAL.stmt = newClassDecl("", yyDollar[1].str, yyDollar[2].str, yyDollar[3].heritage, yyDollar[4].block)
+		}
+	case 111:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:272
+		{
+			yyVAL.stmt = newClassDecl(yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].heritage, yyDollar[5].block)
+		}
+	case 112:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:273
+		{
+			yyVAL.stmt = newClassDecl("", yyDollar[1].str, yyDollar[2].str, yyDollar[3].heritage, yyDollar[4].block)
+		}
+	case 113:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:274
+		{
+			yyVAL.stmt = newClassDecl(yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].heritage, yyDollar[5].block)
+		}
+	case 114:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:275
+		{
+			yyDollar[4].class.Modifiers, yyDollar[4].class.Kind, yyDollar[4].class.Name = "", yyDollar[1].str, yyDollar[2].str
+			yyDollar[4].class.Implements = yyDollar[3].heritage[1]
+			yyVAL.stmt = yyDollar[4].class
+		}
+	case 115:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:276
+		{
+			yyDollar[5].class.Modifiers, yyDollar[5].class.Kind, yyDollar[5].class.Name = yyDollar[1].str, yyDollar[2].str, yyDollar[3].str
+			yyDollar[5].class.Implements = yyDollar[4].heritage[1]
+			yyVAL.stmt = yyDollar[5].class
+		}
+	case 116:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:279
+		{
+			yyVAL.heritage = [2][]string{}
+		}
+	case 117:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:280
+		{
+			yyDollar[1].heritage[0] = yyDollar[3].strs
+			yyVAL.heritage = yyDollar[1].heritage
+		}
+	case 118:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:281
+		{
+			yyDollar[1].heritage[1] = yyDollar[3].strs
+			yyVAL.heritage = yyDollar[1].heritage
+		}
+	case 119:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:283
+		{
+			yyVAL.strs = []string{yyDollar[1].str}
+		}
+	case 120:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:284
+		{
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
+		}
+	case 121:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:287
+		{
+			yyVAL.class = &ClassDecl{Constants: yyDollar[3].list.enclose(yyDollar[2].breaks, yyDollar[4].breaks), Body: &Block{}}
+		}
+	case 122:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:288
+		{
+			yyDollar[3].list.Comma = true
+			yyVAL.class = &ClassDecl{Constants: yyDollar[3].list.enclose(yyDollar[2].breaks, yyDollar[5].breaks), Body: &Block{}}
+		}
+	case 123:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:289
+		{
+			yyVAL.class = &ClassDecl{Constants: yyDollar[3].list.enclose(yyDollar[2].breaks, nil), Body: &Block{Stmts: yyDollar[5].stmts}}
+		}
+	case 124:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:293
+		{
+			yyVAL.ifstmt = &IfStmt{Cond: &ParenExpr{X: yyDollar[4].expr, Lparen: yyDollar[3].breaks, Rparen: yyDollar[5].breaks}, Then: yyDollar[7].stmt}
+		}
+	case 125:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:294
+		{
+			yyDollar[1].ifstmt.lastIf().Else = yyDollar[3].stmt
+			yyVAL.ifstmt = yyDollar[1].ifstmt
+		}
+	case 126:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:296
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 127:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:297
+		{
+			yyVAL.str = "*"
+		}
+	case 128:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:298
+		{
+			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
+		}
+	case 129:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:300
+		{
+			yyVAL.list = &ExprList{}
+		}
+	case 130:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:301
+		{
+			yyVAL.list = newExprList(yyDollar[1].expr)
+		}
+	case 131:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:302
+		{
+			yyVAL.list = yyDollar[1].list.append(yyDollar[3].breaks, yyDollar[4].expr)
+		}
+	case 132:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:304
+		{
+			yyVAL.list = newExprList(yyDollar[1].expr)
+		}
+	case 133:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:305
+		{
+			yyVAL.list = yyDollar[1].list.append(yyDollar[3].breaks, yyDollar[4].expr)
+		}
+	case 134:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:308
+		{
+			yyVAL.expr = &KeyValue{Key: &Ident{Name: yyDollar[1].str, Comments: yyDollar[1].comments}, Value: yyDollar[3].expr}
+		}
+	case 135:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:309
+		{
+			yyVAL.expr = &KeyValue{Key: &BasicLit{Kind: NUMBER, Value: yyDollar[1].str, Comments: yyDollar[1].comments}, Value: yyDollar[3].expr}
+		}
+	case 136:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:310
+		{
+			yyVAL.expr = &KeyValue{Key: &BasicLit{Kind: STRING, Value: yyDollar[1].str, Comments: yyDollar[1].comments}, Value: yyDollar[3].expr}
+		}
+	case 137:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:313
+		{
+			yyVAL.expr = &KeyValue{Key: &ParenExpr{X: yyDollar[3].expr, Lparen: yyDollar[2].breaks, Rparen: yyDollar[4].breaks}, Value: yyDollar[7].expr}
+		}
+	case 138:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:315
+		{
+			yyVAL.expr = &KeyValue{Value: yyDollar[3].expr}
+		}
+	case 139:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:319
+		{
+			yyVAL.list = &ExprList{}
+		}
+	case 140:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:320
+		{
+			yyVAL.list = newExprList(yyDollar[1].expr)
+		}
+	case 141:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:321
+		{
+			yyVAL.list = yyDollar[1].list.append(yyDollar[3].breaks, yyDollar[4].expr)
+		}
+	case 142:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:323
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 143:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:324
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 144:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:326
+		{
+			yyVAL.list = &ExprList{}
+		}
+	case 145:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:327
+		{
+			yyVAL.list = newExprList(yyDollar[1].expr)
+		}
+	case 146:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:328
+		{
+			yyVAL.list = yyDollar[1].list.append(yyDollar[3].breaks, yyDollar[4].expr)
+		}
+	case 147:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:330
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 148:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:331
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 149:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:333
+		{
+			yyVAL.expr = &Param{Type: yyDollar[1].str, Name: yyDollar[2].str}
+		}
+	case 150:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:334
+		{
+			yyVAL.expr = &Param{Type: yyDollar[1].str, Name: yyDollar[2].str, Default: yyDollar[4].expr}
+		}
+	case 151:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:335
+		{
+			yyVAL.expr = &Param{Type: yyDollar[1].str, Name: yyDollar[2].str}
+		}
+	case 152:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:336
+		{
+			yyVAL.expr = &Param{Type: yyDollar[1].str, Name: yyDollar[2].str, Default: yyDollar[4].expr}
+		}
+	case 153:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:337
+		{
+			yyVAL.expr = &Param{Name: yyDollar[1].str, Default: yyDollar[3].expr}
+		}
+	case 154:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:340
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 155:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:341
+		{
+			yyVAL.expr = &ListLit{Elems: yyDollar[3].list.enclose(yyDollar[2].breaks, yyDollar[4].breaks).close(yyDollar[5].comments)}
+		}
+	case 156:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:342
+		{
+			yyDollar[3].list.Comma = true
+			yyVAL.expr = &ListLit{Elems: yyDollar[3].list.enclose(yyDollar[2].breaks, yyDollar[5].breaks).close(yyDollar[6].comments)}
+		}
+	case 157:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:343
+		{
+			yyVAL.expr = &MapLit{Elems: yyDollar[3].list.enclose(yyDollar[2].breaks, yyDollar[4].breaks).close(yyDollar[5].comments)}
+		}
+	case 158:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:344
+		{
+			yyVAL.expr = &MapLit{Elems: (&ExprList{}).enclose(yyDollar[2].breaks, yyDollar[4].breaks)}
+		}
+	case 159:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:345
+		{
+			yyDollar[3].list.Comma = true
+			yyVAL.expr = &MapLit{Elems: yyDollar[3].list.enclose(yyDollar[2].breaks, yyDollar[5].breaks).close(yyDollar[6].comments)}
+		}
+	case 160:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:347
+		{
+			yyVAL.expr = &CallExpr{Fun: &Ident{Name: yyDollar[1].str, Comments: yyDollar[1].comments}, Args: yyDollar[4].list.enclose(yyDollar[3].breaks, yyDollar[5].breaks).close(yyDollar[6].comments)}
+		}
+	case 161:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:349
+		{
+			yyVAL.expr = &CallExpr{Fun: yyDollar[1].expr, Args: yyDollar[4].list.enclose(yyDollar[3].breaks, yyDollar[5].breaks).close(yyDollar[6].comments)}
+		}
+	case 162:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:351
+		{
+			yyVAL.expr = &CallExpr{Fun: &Ident{Name: yyDollar[1].str}, Args: yyDollar[4].list.enclose(yyDollar[3].breaks, yyDollar[5].breaks)}
+		}
+	case 163:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:352
+		{
+			yyVAL.expr = &ClosureExpr{Body: yyDollar[1].block}
+		}
+	case 164:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:354
+		{
+			yyVAL.expr = newClosureCall(yyDollar[1].expr, yyDollar[2].block)
+		}
+	case 165:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:355
+		{
+			yyVAL.expr = &ParenExpr{X: &NamedArgs{Elems: yyDollar[3].list.enclose(yyDollar[2].breaks, yyDollar[4].breaks)}}
+		}
+	case 166:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:356
+		{
+			yyVAL.expr = &SelectorExpr{X: yyDollar[1].expr, Op: ".", Sel: yyDollar[3].str}
+		}
+	case 167:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:357
+		{
+			yyVAL.expr = &SelectorExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Sel: yyDollar[3].str}
+		}
+	case 168:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:358
+		{
+			yyVAL.expr = &SelectorExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Sel: yyDollar[3].str}
+		}
+	case 169:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:359
+		{
+			yyVAL.expr = &SelectorExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Sel: yyDollar[3].str}
+		}
+	case 170:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:360
+		{
+			yyVAL.expr = &SelectorExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Sel: yyDollar[3].str}
+		}
+	case 171:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:361
+		{
+			yyVAL.expr = &IndexExpr{X: yyDollar[1].expr, Index: yyDollar[4].list.enclose(yyDollar[3].breaks, yyDollar[5].breaks)}
+		}
+	case 172:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:362
+		{
+			yyVAL.expr = &NewExpr{Type: yyDollar[2].str, Args: yyDollar[5].list.enclose(yyDollar[4].breaks, yyDollar[6].breaks).close(yyDollar[7].comments)}
+		}
+	case 173:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:363
+		{
+			yyVAL.expr = &UnaryExpr{Op: "-", X: yyDollar[2].expr}
+		}
+	case 174:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:364
+		{
+			yyVAL.expr = &UnaryExpr{Op: "+", X: yyDollar[2].expr}
+		}
+	case 175:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:365
+		{
+			yyVAL.expr = &UnaryExpr{Op: "!", X: yyDollar[2].expr}
+		}
+	case 176:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:366
+		{
+			yyVAL.expr = &UnaryExpr{Op: "~", X: yyDollar[2].expr}
+		}
+	case 177:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:368
+		{
+			yyVAL.expr = &UnaryExpr{Op: "*", X: yyDollar[2].expr}
+		}
+	case 178:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:369
+		{
+			yyVAL.expr = &UnaryExpr{Op: yyDollar[1].str, X: yyDollar[2].expr}
+		}
+	case 179:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:370
+		{
+			yyVAL.expr = &UnaryExpr{Op: yyDollar[1].str, X: yyDollar[2].expr}
+		}
+	case 180:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:371
+		{
+			yyVAL.expr = &CondExpr{Cond: yyDollar[1].expr, Then: yyDollar[3].expr, Else: yyDollar[5].expr}
+		}
+	case 181:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:372
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: yyDollar[3].expr}
+		}
+	case 182:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:373
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: yyDollar[3].expr}
+		}
+	case 183:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:374
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: yyDollar[3].expr}
+		}
+	case 184:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:375
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: yyDollar[3].expr}
+		}
+	case 185:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:376
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: yyDollar[3].expr}
+		}
+	case 186:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:377
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: yyDollar[3].expr}
+		}
+	case 187:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:378
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: yyDollar[3].expr}
+		}
+	case 188:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:379
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "&", Y: yyDollar[3].expr}
+		}
+	case 189:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:380
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "^", Y: yyDollar[3].expr}
+		}
+	case 190:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:381
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "|", Y: yyDollar[3].expr}
+		}
+	case 191:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:382
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: yyDollar[3].expr}
+		}
+	case 192:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:383
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: yyDollar[3].expr}
+		}
+	case 193:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:384
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: yyDollar[3].expr}
+		}
+	case 194:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:385
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: yyDollar[3].expr}
+		}
+	case 195:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:386
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: yyDollar[3].expr}
+		}
+	case 196:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:387
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: &Ident{Name: yyDollar[3].str}}
+		}
+	case 197:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:388
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: &Ident{Name: yyDollar[3].str}}
+		}
+	case 198:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:389
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: &Ident{Name: yyDollar[3].str}}
+		}
+	case 199:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:390
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "<", Y: yyDollar[3].expr}
+		}
+	case 200:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:391
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: ">", Y: yyDollar[3].expr}
+		}
+	case 201:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:392
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "-", Y: yyDollar[3].expr}
+		}
+	case 202:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:393
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "+", Y: yyDollar[3].expr}
+		}
+	case 203:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:394
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "*", Y: yyDollar[3].expr}
+		}
+	case 204:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:395
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "/", Y: yyDollar[3].expr}
+		}
+	case 205:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:396
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "%", Y: yyDollar[3].expr}
+		}
+	case 206:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:397
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "==", Y: yyDollar[3].expr}
+		}
+	case 207:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:398
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "!=", Y: yyDollar[3].expr}
+		}
+	case 208:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:399
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: yyDollar[2].str, Y: yyDollar[3].expr}
+		}
+	case 209:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:400
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: ">=", Y: yyDollar[3].expr}
+		}
+	case 210:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:401
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "<=", Y: yyDollar[3].expr}
+		}
+	case 211:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:402
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "&&", Y: yyDollar[3].expr}
+		}
+	case 212:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:403
+		{
+			yyVAL.expr = &BinaryExpr{X: yyDollar[1].expr, Op: "||", Y: yyDollar[3].expr}
+		}
+	case 213:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:405
+		{
+			yyVAL.expr = &IncDecExpr{X: &Ident{Name: yyDollar[1].str}, Op: yyDollar[2].str}
+		}
+	case 214:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:406
+		{
+			yyVAL.expr = &IncDecExpr{X: &Ident{Name: yyDollar[1].str}, Op: yyDollar[2].str}
+		}
+	case 215:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:407
+		{
+			yyVAL.expr = &IncDecExpr{X: yyDollar[1].expr, Op: yyDollar[2].str}
+		}
+	case 216:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:408
+		{
+			yyVAL.expr = &IncDecExpr{X: yyDollar[1].expr, Op: yyDollar[2].str}
+		}
+	case 217:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:411
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 218:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:412
+		{
+			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
+		}
+	case 219:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:415
+		{
+			yyVAL.expr = &BasicLit{Kind: NUMBER, Value: yyDollar[1].str, Comments: yyDollar[1].comments}
+		}
+	case 220:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:416
+		{
+			yyVAL.expr = &BasicLit{Kind: STRING, Value: yyDollar[1].str, Comments: yyDollar[1].comments}
+		}
+	case 221:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:417
+		{
+			yyVAL.expr = &BasicLit{Kind: BOOL, Value: yyDollar[1].str, Comments: yyDollar[1].comments}
+		}
+	case 222:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:419
+		{
+			yyVAL.expr = &Ident{Name: yyDollar[1].str, Comments: yyDollar[1].comments}
+		}
+	case 223:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:421
+		{
+			yyVAL.expr = &ParenExpr{X: yyDollar[3].expr, Lparen: yyDollar[2].breaks, Rparen: yyDollar[4].breaks}
+		}
+	}
+	goto yystack /* stack new state and value */
+}
@@ -0,0 +1,109 @@
+package walk
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// pattern is a pattern of .gitignore format
+type pattern struct {
+	segments []string
+	negate   bool
+	dirOnly  bool
+	anchored bool // NOTE: matches relative path from the base directory instead of the base name
+}
+
+func parsePattern(line string) (pattern, bool) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return pattern{}, false
+	}
+	var p pattern
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	}
+	line = strings.TrimPrefix(line, "\\")
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if strings.Contains(line, "/") {
+		p.anchored = true
+		line = strings.TrimPrefix(line, "/")
+	}
+	if line == "" {
+		return pattern{}, false
+	}
+	p.segments = strings.Split(line, "/")
+	return p, true
+}
+
+// match reports whether rel (slash separated path relative to the base directory) matches the pattern
+func (p pattern) match(rel string, isDir bool) bool {
+	if p.dirOnly && !isDir {
+		return false
+	}
+	if !p.anchored {
+		return matchSegments(p.segments, []string{path.Base(rel)})
+	}
+	return matchSegments(p.segments, strings.Split(rel, "/"))
+}
+
+// matchSegments matches path segments with glob segments, "**" matches zero or more segments
+func matchSegments(globs []string, names []string) bool {
+	if len(globs) == 0 {
+		return len(names) == 0
+	}
+	if globs[0] == "**" {
+		for i := 0; i <= len(names); i++ {
+			if matchSegments(globs[1:], names[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(names) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(globs[0], names[0]); !ok {
+		return false
+	}
+	return matchSegments(globs[1:], names[1:])
+}
+
+// ignoreList is patterns defined at the base directory
+type ignoreList struct {
+	base     string
+	patterns []pattern
+}
+
+func newIgnoreList(base string, lines []string) ignoreList {
+	if abs, err := filepath.Abs(base); err == nil {
+		base = abs
+	}
+	l := ignoreList{base: base}
+	for _, line := range lines {
+		if p, ok := parsePattern(line); ok {
+			l.patterns = append(l.patterns, p)
+		}
+	}
+	return l
+}
+
+func readIgnoreFile(filename string) ([]string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
@@ -0,0 +1,102 @@
+// Package walk discovers Jenkinsfiles under directories.
+package walk
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultIncludes is the conventional names of Jenkinsfiles
+var DefaultIncludes = []string{
+	"Jenkinsfile",
+	"Jenkinsfile.*",
+	"*.jenkinsfile",
+	"*.Jenkinsfile",
+	"vars/*.groovy",
+}
+
+// NOTE: the backups and the temporary files of inplace edit e.g. `Jenkinsfile.orig`, `.Jenkinsfile.123.tmp`
+// are never formatted even if they match the includes
+var generatedFiles = []string{"*.orig", ".*.tmp"}
+
+// Exclude is patterns of .gitignore format relative to Base directory
+type Exclude struct {
+	Base     string
+	Patterns []string
+}
+
+// Files returns the files under root which match one of includes and do not match excludes nor .gitignore files
+// includes are glob patterns matched against the trailing path elements (e.g. "vars/*.groovy" matches "lib/vars/foo.groovy")
+// including the elements of root (e.g. "vars/*.groovy" matches "foo.groovy" under root "lib/vars")
+func Files(root string, includes []string, excludes ...Exclude) ([]string, error) {
+	var lists []ignoreList
+	for _, exclude := range excludes {
+		lists = append(lists, newIgnoreList(exclude.Base, exclude.Patterns))
+	}
+	ignored := func(path string, isDir bool) bool {
+		result := false
+		path, err := filepath.Abs(path)
+		if err != nil {
+			return false
+		}
+		for _, l := range lists {
+			rel, err := filepath.Rel(l.base, path)
+			if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+				continue
+			}
+			rel = filepath.ToSlash(rel)
+			for _, p := range l.patterns {
+				if p.match(rel, isDir) {
+					result = !p.negate
+				}
+			}
+		}
+		return result
+	}
+
+	var files []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" || ignored(path, true) {
+				return filepath.SkipDir
+			}
+			lines, err := readIgnoreFile(filepath.Join(path, ".gitignore"))
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			if len(lines) > 0 {
+				lists = append(lists, newIgnoreList(path, lines))
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || matchIncludes(generatedFiles, []string{d.Name()}) || ignored(path, false) {
+			return nil
+		}
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+		if matchIncludes(includes, strings.Split(filepath.ToSlash(abs), "/")) {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+func matchIncludes(includes []string, names []string) bool {
+	for _, include := range includes {
+		globs := strings.Split(include, "/")
+		for i := range names {
+			if matchSegments(globs, names[i:]) {
+				return true
+			}
+		}
+	}
+	return false
+}
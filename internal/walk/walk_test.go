@@ -0,0 +1,132 @@
+package walk
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestPatternMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		rel     string
+		isDir   bool
+		want    bool
+	}{
+		{"*.bak", "a.bak", false, true},
+		{"*.bak", "sub/a.bak", false, true},
+		{"*.bak", "a.bak.txt", false, false},
+		{"build/", "build", true, true},
+		{"build/", "build", false, false},
+		{"build/", "sub/build", true, true},
+		{"/build", "build", false, true},
+		{"/build", "sub/build", false, false},
+		{"sub/*.groovy", "sub/a.groovy", false, true},
+		{"sub/*.groovy", "x/sub/a.groovy", false, false},
+		{"**/vars", "a/b/vars", true, true},
+		{"**/vars", "vars", true, true},
+		{"a/**/b", "a/x/y/b", false, true},
+		{"a/**/b", "a/b", false, true},
+		{"a/**", "a/x/y", false, true},
+		{"\\#file", "#file", false, true},
+	}
+	for _, tt := range tests {
+		p, ok := parsePattern(tt.pattern)
+		if !ok {
+			t.Errorf("parsePattern(%q) is not a pattern", tt.pattern)
+			continue
+		}
+		if got := p.match(tt.rel, tt.isDir); got != tt.want {
+			t.Errorf("%q match(%q, %v) = %v, want %v", tt.pattern, tt.rel, tt.isDir, got, tt.want)
+		}
+	}
+}
+
+func TestParsePattern(t *testing.T) {
+	tests := []struct {
+		line string
+		want pattern
+		ok   bool
+	}{
+		{"", pattern{}, false},
+		{"# comment", pattern{}, false},
+		{"/", pattern{}, false},
+		{"*.bak  ", pattern{segments: []string{"*.bak"}}, true},
+		{"!keep.bak", pattern{segments: []string{"keep.bak"}, negate: true}, true},
+		{"/a/b/", pattern{segments: []string{"a", "b"}, dirOnly: true, anchored: true}, true},
+	}
+	for _, tt := range tests {
+		got, ok := parsePattern(tt.line)
+		if ok != tt.ok || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parsePattern(%q) = %+v, %v, want %+v, %v", tt.line, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestFiles(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"Jenkinsfile":                   "",
+		"Jenkinsfile.prod":              "",
+		"Jenkinsfile.orig":              "",
+		".Jenkinsfile.123.tmp":          "",
+		"deploy.jenkinsfile":            "",
+		"README.md":                     "",
+		"vars/build.groovy":             "",
+		"vars/build.groovy.orig":        "",
+		"src/Foo.groovy":                "",
+		"lib/vars/test.groovy":          "",
+		"third_party/Jenkinsfile":       "",
+		"ignored/Jenkinsfile":           "",
+		"keep/Jenkinsfile":              "",
+		"keep/Jenkinsfile.bak":          "",
+		".gitignore":                    "ignored/\n",
+		"keep/.gitignore":               "*.bak\n!Jenkinsfile\n",
+		".git/Jenkinsfile":              "",
+		"third_party/sub/Jenkinsfile.x": "",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := Files(root, DefaultIncludes, Exclude{Base: root, Patterns: []string{"third_party/"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, path := range got {
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got[i] = filepath.ToSlash(rel)
+	}
+	sort.Strings(got)
+	want := []string{
+		"Jenkinsfile",
+		"Jenkinsfile.prod",
+		"deploy.jenkinsfile",
+		"keep/Jenkinsfile",
+		"lib/vars/test.groovy",
+		"vars/build.groovy",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Files() = %q, want %q", got, want)
+	}
+
+	// NOTE: the includes match the elements of root e.g. `goenkins-format -l lib/vars`
+	vars := filepath.Join(root, "lib", "vars")
+	got, err = Files(vars, DefaultIncludes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{filepath.Join(vars, "test.groovy")}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Files(%q) = %q, want %q", vars, got, want)
+	}
+}
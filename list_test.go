@@ -0,0 +1,31 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	tests := []struct {
+		files    map[string]string
+		want     string
+		wantCode int
+	}{
+		{map[string]string{"Jenkinsfile": formatted}, "", 0},
+		{map[string]string{"Jenkinsfile": unformatted, "Jenkinsfile.prod": formatted}, "Jenkinsfile\n", exitCodeUnformatted},
+		{map[string]string{"Jenkinsfile": unformatted, "Jenkinsfile.prod": unformatted}, "Jenkinsfile\nJenkinsfile.prod\n", exitCodeUnformatted},
+		{map[string]string{"Jenkinsfile": "pipeline {", "Jenkinsfile.prod": formatted}, "", 1},
+	}
+	for _, tt := range tests {
+		dir := writeFiles(t, tt.files)
+		got, code := run(t, dir, "-l", ".")
+		if got != tt.want || code != tt.wantCode {
+			t.Errorf("-l of %v = %q with exit status %d, want %q with %d", tt.files, got, code, tt.want, tt.wantCode)
+		}
+		for name, content := range tt.files {
+			if readFile(t, filepath.Join(dir, name)) != content {
+				t.Errorf("-l rewrites %s", name)
+			}
+		}
+	}
+}
@@ -1,130 +1,250 @@
 package main
 
 import (
-	"errors"
+	"bytes"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
+
+	"github.com/umaumax/goenkins-format/format"
+	"github.com/umaumax/goenkins-format/internal/diff"
+	"github.com/umaumax/goenkins-format/internal/walk"
 )
 
 var (
 	indentSapceNum int
+	indentStyle    string
+	quoteStyle     string
+	trailingComma  string
+	maxBlankLines  int
+	maxLineWidth   int
+	sectionBlank   bool
 	overwritFlag   bool
+	backupFlag     bool
+	listFlag       bool
+	diffFlag       bool
+	jobNum         int
+	includes       = stringsFlag{values: walk.DefaultIncludes}
+	excludes       stringsFlag
 )
 
-func init() {
-	flag.IntVar(&indentSapceNum, "indent_num", 2, "number of spaces of indent")
-	flag.BoolVar(&overwritFlag, "i", false, "Inplace edit <file>s, if specified. Don't use at /dev/stdin")
+// stringsFlag is a comma separated list flag which can be specified multiple times
+// The default values are replaced by the specified values
+type stringsFlag struct {
+	values []string
+	set    bool
 }
 
-var (
-	outputStream OutputStream
-)
-
-type OutputStream struct {
-	output         string
-	outputNewFlag  bool
-	indentSapceNum int
+func (f *stringsFlag) String() string {
+	return strings.Join(f.values, ",")
 }
 
-func (s *OutputStream) Truncate() {
-	s.output = ""
-	s.outputNewFlag = false
+func (f *stringsFlag) Set(value string) error {
+	if !f.set {
+		f.values = nil
+		f.set = true
+	}
+	for _, v := range strings.Split(value, ",") {
+		if v != "" {
+			f.values = append(f.values, v)
+		}
+	}
+	return nil
 }
 
-func (s *OutputStream) SetIndentSpaceNum(indentSapceNum int) {
-	s.indentSapceNum = indentSapceNum
+const (
+	// NOTE: exit status of -l when there are unformatted files
+	exitCodeUnformatted = 2
+)
+
+func init() {
+	flag.IntVar(&indentSapceNum, "indent_num", format.DefaultIndentSpaceNum, "number of spaces of indent")
+	flag.StringVar(&indentStyle, "indent_style", format.IndentSpace, "character of indent (space|tab)")
+	flag.StringVar(&quoteStyle, "quote_style", format.QuotePreserve, "quote of string literals (preserve|single|double)")
+	flag.StringVar(&trailingComma, "trailing_comma", format.TrailingCommaPreserve, "trailing comma of multi-line list and map literals (preserve|always|never)")
+	flag.IntVar(&maxBlankLines, "max_blank_lines", format.DefaultMaxBlankLines, "maximum number of consecutive blank lines (0 removes all blank lines)")
+	flag.IntVar(&maxLineWidth, "max_line_width", format.DefaultMaxLineWidth, "width which long arguments, list and map literals and binary expressions are broken at")
+	flag.BoolVar(&sectionBlank, "blank_line_between_sections", false, "insert a blank line between pipeline sections and between stages")
+	flag.BoolVar(&overwritFlag, "i", false, "Inplace edit <file>s, if specified. Don't use at /dev/stdin")
+	flag.BoolVar(&backupFlag, "backup", false, "keep the original file as <file>.orig at inplace edit")
+	flag.BoolVar(&listFlag, "l", false, "list files whose formatting differs from goenkins-format's and exit with status 2 without editing them")
+	flag.BoolVar(&diffFlag, "d", false, "display unified diffs instead of rewriting files")
+	flag.Var(&includes, "include", "comma separated glob patterns of files to format in directories")
+	flag.Var(&excludes, "exclude", "comma separated .gitignore style patterns of files to skip in directories")
+	flag.IntVar(&jobNum, "j", runtime.NumCPU(), "number of files formatted concurrently")
 }
 
-func (s *OutputStream) SetNewLineFlag() {
-	s.outputNewFlag = true
+type result struct {
+	src    []byte
+	output []byte
+	err    error
 }
 
-func (s *OutputStream) TrimSpace() {
-	s.output = strings.TrimRight(s.output, " ")
-	if strings.HasSuffix(s.output, "\n") {
-		s.output = strings.TrimRight(s.output, "\n") + "\n"
+func displayName(inputFile string) string {
+	if inputFile == "-" {
+		return "<standard input>"
 	}
+	return inputFile
 }
-func (s *OutputStream) Write(indent_level int, args ...interface{}) {
-	if s.outputNewFlag {
-		s.output += fmt.Sprint(s.genIndent(indent_level))
-		s.outputNewFlag = false
-	}
-	withSpaceKeywords := []string{"if", ":"}
-	for _, v := range withSpaceKeywords {
-		if strings.HasSuffix(s.output, v) {
-			s.output += " "
-			break
+
+func formatFile(inputFile string, configs *configLoader) ([]byte, []byte, error) {
+	opts, err := configs.options(inputFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	file := os.Stdin
+	opts.Filename = displayName(inputFile)
+	if inputFile != "-" {
+		file, err = os.Open(inputFile)
+		if err != nil {
+			return nil, nil, err
 		}
+		defer file.Close()
 	}
-	s.output += fmt.Sprint(args...)
-}
-func (s *OutputStream) genIndent(indent_level int) string {
-	return strings.Repeat(strings.Repeat(" ", s.indentSapceNum), indent_level)
+	src, err := io.ReadAll(file)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Read: %w", err)
+	}
+	output, err := format.Format(src, opts)
+	return src, output, err
 }
 
-func (s *OutputStream) String() string {
-	return s.output
-}
+// writeFile replaces inputFile with output atomically by renaming a temporary file in the same directory
+func writeFile(inputFile string, src []byte, output []byte) error {
+	if bytes.Equal(src, output) {
+		return nil
+	}
+	// NOTE: rename replaces a symbolic link itself
+	inputFile, err := filepath.EvalSymlinks(inputFile)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(inputFile)
+	if err != nil {
+		return err
+	}
+	perm := info.Mode().Perm()
+	if backupFlag {
+		if err := os.WriteFile(inputFile+".orig", src, perm); err != nil {
+			return fmt.Errorf("Backup: %w", err)
+		}
+	}
 
-type LexerWrapper struct {
-	*Lexer
+	file, err := os.CreateTemp(filepath.Dir(inputFile), "."+filepath.Base(inputFile)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpFile := file.Name()
+	defer os.Remove(tmpFile)
+	if _, err := file.Write(output); err != nil {
+		file.Close()
+		return fmt.Errorf("Write: %w", err)
+	} else if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("Sync: %w", err)
+	} else if err := file.Close(); err != nil {
+		return fmt.Errorf("Close: %w", err)
+	} else if err := os.Chmod(tmpFile, perm); err != nil {
+		return fmt.Errorf("Chmod: %w", err)
+	} else if err := os.Rename(tmpFile, inputFile); err != nil {
+		return fmt.Errorf("Rename: %w", err)
+	}
+	return nil
 }
 
-func (yylex LexerWrapper) Error(e string) {
-	log.Println(e)
+// expandInputFiles replaces directories with the Jenkinsfiles under them
+func expandInputFiles(args []string, configs *configLoader) ([]string, error) {
+	var inputFiles []string
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil || !info.IsDir() {
+			// NOTE: errors are reported by the per-file loop
+			inputFiles = append(inputFiles, arg)
+			continue
+		}
+		walkExcludes := []walk.Exclude{{Base: arg, Patterns: excludes.values}}
+		c, err := configs.load(arg)
+		if err != nil {
+			return nil, err
+		}
+		if c != nil {
+			walkExcludes = append(walkExcludes, walk.Exclude{Base: c.Dir(), Patterns: c.Exclude})
+		}
+		files, err := walk.Files(arg, includes.values, walkExcludes...)
+		if err != nil {
+			return nil, err
+		}
+		inputFiles = append(inputFiles, files...)
+	}
+	return inputFiles, nil
 }
 
 func main() {
 	flag.Parse()
+	// NOTE: print errors as `file:line:col: message` for editors and CI
+	log.SetFlags(0)
 
-	outputStream.SetIndentSpaceNum(indentSapceNum)
+	configs := newConfigLoader()
+	if jobNum < 1 {
+		jobNum = 1
+	}
 
 	// NOTE: default input file is input pipe
 	inputFiles := []string{"-"}
 	if flag.NArg() > 0 {
-		inputFiles = flag.Args()
+		var err error
+		inputFiles, err = expandInputFiles(flag.Args(), configs)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// NOTE: format files concurrently and consume the results in the order of inputFiles
+	results := make([]chan result, len(inputFiles))
+	semaphore := make(chan struct{}, jobNum)
+	for i, inputFile := range inputFiles {
+		results[i] = make(chan result, 1)
+		go func(inputFile string, ch chan<- result) {
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			src, output, err := formatFile(inputFile, configs)
+			ch <- result{src: src, output: output, err: err}
+		}(inputFile, results[i])
 	}
+
 	completeNum := 0
+	unformattedNum := 0
 	totalNum := len(inputFiles)
-	for _, inputFile := range inputFiles {
-		file := os.Stdin
-		if inputFile != "-" {
-			file, err := os.OpenFile(inputFile, os.O_RDWR, 0666)
-			if err != nil {
-				log.Println(err)
-				continue
-			}
-			defer file.Close()
-		}
-
-		outputStream.Truncate()
-		lexer := LexerWrapper{Lexer: NewLexer(file)}
-		if yyParse(lexer) != 0 {
-			log.Println(errors.New("hint fot error"))
-			outputStream.TrimSpace()
-			fmt.Fprintln(os.Stderr, "[", outputStream.output, "]")
+	for i, inputFile := range inputFiles {
+		r := <-results[i]
+		if r.err != nil {
+			log.Println(r.err)
 			continue
 		}
 
-		outputStream.TrimSpace()
-
-		if overwritFlag {
-			if err := file.Truncate(0); err != nil {
-				log.Println("Truncate:", err)
-				continue
-			} else if _, err := file.Seek(0, 0); err != nil {
-				log.Println("Seek:", err)
-				continue
-			} else if _, err := fmt.Fprint(file, outputStream.output); err != nil {
-				log.Println("Write:", err)
+		if listFlag || diffFlag {
+			if !bytes.Equal(r.src, r.output) {
+				if listFlag {
+					fmt.Println(displayName(inputFile))
+					unformattedNum++
+				}
+				if diffFlag {
+					name := displayName(inputFile)
+					os.Stdout.Write(diff.Unified(name+".orig", name, r.src, r.output))
+				}
+			}
+		} else if overwritFlag && inputFile != "-" {
+			if err := writeFile(inputFile, r.src, r.output); err != nil {
+				log.Println(err)
 				continue
 			}
 		} else {
-			fmt.Print(outputStream.output)
+			os.Stdout.Write(r.output)
 		}
 
 		completeNum++
@@ -133,4 +253,7 @@ func main() {
 		fmt.Fprintf(os.Stderr, "failed processing (%d/%d)", totalNum-completeNum, totalNum)
 		os.Exit(1)
 	}
+	if unformattedNum > 0 {
+		os.Exit(exitCodeUnformatted)
+	}
 }
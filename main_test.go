@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	formatted   = "pipeline {\n  agent any\n}\n"
+	unformatted = "pipeline {\nagent any\n}\n"
+)
+
+// NOTE: the test binary runs main() as the command when this variable is set
+const mainEnv = "GOENKINS_FORMAT_TEST_MAIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(mainEnv) != "" {
+		os.Args = append([]string{os.Args[0]}, strings.Fields(os.Getenv(mainEnv))...)
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// run runs the command with args in dir and returns stdout and the exit status
+func run(t *testing.T, dir string, args ...string) (string, int) {
+	t.Helper()
+	cmd := exec.Command(os.Args[0])
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), mainEnv+"="+strings.Join(args, " "))
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return stdout.String(), exitErr.ExitCode()
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stdout.String(), 0
+}
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func readFile(t *testing.T, filename string) string {
+	t.Helper()
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestDiff(t *testing.T) {
+	dir := writeFiles(t, map[string]string{"Jenkinsfile": unformatted})
+	got, code := run(t, dir, "-d", "Jenkinsfile")
+	want := "--- Jenkinsfile.orig\n+++ Jenkinsfile\n@@ -1,3 +1,3 @@\n pipeline {\n-agent any\n+  agent any\n }\n"
+	if got != want || code != 0 {
+		t.Errorf("-d = %q with exit status %d, want %q", got, code, want)
+	}
+	if readFile(t, filepath.Join(dir, "Jenkinsfile")) != unformatted {
+		t.Error("-d rewrites the file")
+	}
+}
+
+func TestEditorConfigIndent(t *testing.T) {
+	editorconfig := "root = true\n[*]\nindent_style = space\nindent_size = 4\n"
+	tests := []struct {
+		files map[string]string
+		args  []string
+		want  string
+	}{
+		{map[string]string{}, nil, "pipeline {\n    agent any\n}\n"},
+		// NOTE: the indent of .editorconfig is ignored as a whole
+		{map[string]string{}, []string{"-indent_style", "tab"}, "pipeline {\n\tagent any\n}\n"},
+		{map[string]string{".goenkins-format.yaml": "indent_width: 2\n"}, nil, formatted},
+		{map[string]string{".goenkins-format.yaml": "quote_style: single\n"}, nil, "pipeline {\n    agent any\n}\n"},
+	}
+	for _, tt := range tests {
+		tt.files[".editorconfig"] = editorconfig
+		tt.files["Jenkinsfile"] = unformatted
+		dir := writeFiles(t, tt.files)
+		got, code := run(t, dir, append(tt.args, "Jenkinsfile")...)
+		if got != tt.want || code != 0 {
+			t.Errorf("%v with %v = %q with exit status %d, want %q", tt.args, tt.files, got, code, tt.want)
+		}
+	}
+}
+
+func TestInvalidFlags(t *testing.T) {
+	for _, args := range [][]string{{"-indent_num", "-1"}, {"-max_blank_lines", "-1"}} {
+		dir := writeFiles(t, map[string]string{"Jenkinsfile": unformatted})
+		if got, code := run(t, dir, append(args, "-i", "Jenkinsfile")...); got != "" || code != 1 {
+			t.Errorf("%v = %q with exit status %d, want exit status 1", args, got, code)
+		}
+		if readFile(t, filepath.Join(dir, "Jenkinsfile")) != unformatted {
+			t.Errorf("%v rewrites the file", args)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+/*
+ * Licensed under the MIT License
+ *
+ *   indented text is kept
+ */
+pipeline {
+agent any
+        /**
+          * Build the project
+          *  @param target the make target
+        */
+stages {
+  stage('Build') {
+    steps {
+                /* first line
+                   continued
+                     nested */
+      sh 'make'
+      echo 'x' /* trailing
+                  continued */
+    }
+  }
+}
+}
@@ -0,0 +1,21 @@
+def a = ['foo-bar': 1, "baz": 2, (name): 3, *: defaults, default: 4, in: 5]
+def e = [:]
+def l = []
+def s = [*xs, 1]
+f(*args)
+def nested = [[a: 1, b: [1, 2]], [c: [x: 'y']]]
+def long = [alpha: 'aaaaaaaaaaaaaaaaaaaa', beta: 'bbbbbbbbbbbbbbbbbbbbbbbbb', gamma: 'cccccccccccccccccccccc', delta: 'dddddddddd']
+def m = [a: 1,
+  b: 2]
+def n = [
+  a: 1, b: 2,
+]
+def c = [
+  1, // one
+  2
+]
+def x = [ // comment
+]
+parallel 'a': { echo 1 }, 'b': { echo 2 }
+def z = [a: 1] + [b: 2]
+def stages = targets.collectEntries { t -> [(t): { stage(t) { sh "make ${t}" } }] }
@@ -0,0 +1,87 @@
+switch(x){
+case 1:
+echo 'one'
+break
+case 'a':
+case 'b':
+  echo 'fall through'
+case { it > 10 }:
+echo 'closure'
+   break
+default:
+echo 'default'
+}
+switch(y){
+// first case
+case 1:
+echo 'one'
+  // second case
+case 2:
+echo 'two'
+
+// default case
+default:
+echo 'default'
+}
+while(i<10){
+i++
+if(i==5){continue}
+}
+do{
+i--
+}while(i>0)
+outer:
+for(x in xs){
+for(y in ys){
+if(x==y){break outer}
+continue outer
+}
+}
+try{
+sh 'make'
+}catch(IOException|InterruptedException e){
+throw e
+}catch(e){
+return
+}finally{
+echo 'done'
+}
+def f(x){
+  return x ? 1 : 2
+}
+if(x)return
+if(x) echo 'a' else if(y) echo 'b' else { echo 'c' }
+while(i>0) i--
+for(String s in xs) echo s
+for(def s in xs){
+echo s
+}
+if (
+  // why
+  a) {
+b()
+}
+while (x // trailing
+) {
+x--
+}
+if (a) {
+b()
+}
+else if (c) {
+d()
+}
+else {
+e()
+}
+try {
+sh 'make'
+}
+catch (e) {
+throw e
+}
+finally {
+echo 'done'
+}
+if (a) b()
+else c()
@@ -3,3 +3,14 @@ pipeline {
     def a = 1 + 1
   }
 }
+def y = (
+  // note
+  a + b)
+def z = (a + b // why
+)
+echo (
+  // message
+  'x')
+def m = [(
+  // key
+  k): 1]
@@ -0,0 +1,17 @@
+pipeline {
+  agent any
+  stages {
+    stage('Build') {
+      when { expression { return env.BRANCH_NAME == 'master' && env.CHANGE_ID == null && params.DEPLOY == true && params.SKIP != true && params.FORCE != true } }
+      steps {
+        mail to: 'team@example.com', subject: "Build ${env.BUILD_NUMBER} of ${env.JOB_NAME} failed", body: "See ${env.BUILD_URL} for details"
+        build(job: 'downstream-job-with-a-long-name', parameters: [string(name: 'VERSION', value: params.VERSION), booleanParam(name: 'DEPLOY', value: true)], wait: false)
+        foo(a, b) { x -> echo "this is a very long line inside a closure passed as the last argument to a method call of foo" }
+        script {
+          def ok = env.A == 'a' &&
+            env.B == 'b'
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,10 @@
+pipeline {
+  options {
+    timeout(time: 1.5, unit: "HOURS")
+  }
+  script {
+    def a = [0x1F, 10L, 1_000, 2.5G, 1e3, 1.5e-3, 0b101, 3.14d, 1.abs(), 07]
+    def m = [1: "a", 2: "b"]
+    sleep 10
+  }
+}
@@ -0,0 +1,10 @@
+#!groovy
+
+pipeline {
+agent any
+}
+
+// vim: set filetype=groovy ts=2 sw=2 :
+// Local Variables:
+// mode: groovy
+// End:
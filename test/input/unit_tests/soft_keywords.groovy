@@ -0,0 +1,29 @@
+def label = 'linux'
+def index = 0
+def newVersion = '1'
+def shFile = 'a.sh'
+def nodeLabel = 'x'
+pipeline {
+  agent { label label }
+  stages {
+    stage('a') {
+      steps {
+        script {
+          def stage = [label: 'x', node: nodeLabel, dir: 'tmp', script: 'a.sh', agent: 'none', any: 1]
+          stage = params.stage
+          echo label.trim()
+          dir(stage.dir) {
+            echo env.node
+          }
+          def any = none
+        }
+      }
+    }
+  }
+}
+node {
+stage 'Build'
+sh 'make'
+dir 'sub'
+stage "Test ${name}"
+}
@@ -0,0 +1,15 @@
+parallel stages.collectEntries { name ->
+["${name}": {
+stage(name) {
+echo name
+}
+}]
+}
+list.collect { it }.findAll { it }
+foo bar { x }
+retry(3) {
+sh 'make'
+}.toString()
+items.each { item ->
+echo item
+}
@@ -1,4 +1,4 @@
-list =[
+list = [
   'sample1',
   'sample2'
 ]
@@ -25,8 +25,8 @@ pipeline {
   steps {
     dirs('tmp') {
       script {
-        for(e in ary) {
-          break ;
+        for (e in ary) {
+          break;
         }
       }
     }
@@ -48,7 +48,7 @@ pipeline {
     dirs('tmp') {
       script {
         try {
-        } catch(Exception e) {
+        } catch (Exception e) {
         }
       }
     }
@@ -61,7 +61,7 @@ pipeline {
       script {
         ['a', 'b', 'c'].each { x ->
           try {
-          } catch(Exception e) {
+          } catch (Exception e) {
           }
         }
       }
@@ -73,8 +73,7 @@ pipeline {
   steps {
     dirs('tmp') {
       script {
-        for(i = 0 ; i < 10 ; i ++) {
-
+        for (i = 0; i < 10; i++) {
         }
       }
     }
@@ -118,10 +117,10 @@ pipeline {
 
 pipeline {
   script {
-    def ary =[
+    def ary = [
       'a',
     ]
-    def dict =[
+    def dict = [
       a: 'a',
     ]
   }
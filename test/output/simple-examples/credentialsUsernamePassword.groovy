@@ -1,11 +1,11 @@
 pipeline {
   environment {
     /*
-       * Uses a Jenkins credential called "FOOCredentials" and creates environment variables:
-       * "$FOO" will contain string "USR:PSW"
-       * "$FOO_USR" will contain string for Username
-       * "$FOO_PSW" will contain string for Password
-       */
+     * Uses a Jenkins credential called "FOOCredentials" and creates environment variables:
+     * "$FOO" will contain string "USR:PSW"
+     * "$FOO_USR" will contain string for Username
+     * "$FOO_PSW" will contain string for Password
+     */
     FOO = credentials("FOOcredentials")
   }
 
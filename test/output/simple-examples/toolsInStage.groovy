@@ -2,7 +2,6 @@ pipeline {
   agent none
   stages {
     stage("foo") {
-
       agent any
 
       /*
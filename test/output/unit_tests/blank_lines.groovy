@@ -0,0 +1,14 @@
+pipeline {
+  agent any
+
+  stages {
+    stage('a') {
+      // blank lines after '{' and before '}' are removed
+      steps {
+        echo 'a'
+
+        echo 'b'
+      }
+    }
+  }
+}
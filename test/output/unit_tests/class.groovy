@@ -0,0 +1,78 @@
+package org.example.ci
+
+import static org.example.Util.helper
+import groovy.transform.Field
+
+@Library('lib@v1') _
+
+@Field def counter = 0
+
+class Foo implements Serializable {
+  private static final long serialVersionUID = 1L
+  private final Script steps
+  Map<String, List<String>> table = [a: 1]
+  String name
+
+  Foo(steps) {
+    this.steps = steps
+  }
+
+  public Foo(Script steps, String name = 'x') {
+    this.steps = steps
+  }
+
+  @NonCPS
+  String call(Map cfg) {
+    return cfg.name
+  }
+
+  static void main(String[] args) {
+    throw new IllegalStateException("no")
+  }
+
+  String describe() {
+    return name
+  }
+
+  boolean isOk() { true }
+
+  def log(String... messages) {
+    messages.each { echo it }
+  }
+
+  def run(def a, int b = 2) {
+    if (!a) {
+      return
+    }
+  }
+}
+
+interface Runner extends Serializable, Cloneable {
+  void run(Map cfg)
+  String name()
+}
+
+enum Color {
+  RED, GREEN,
+  BLUE
+}
+
+enum Level implements Serializable { LOW, HIGH }
+
+enum Size {
+  SMALL(1),
+  LARGE(2);
+
+  final int value
+
+  Size(int value) {
+    this.value = value
+  }
+}
+
+void setup() {
+}
+
+def call(Map config = [a: 1]) {
+  echo config.name
+}
@@ -0,0 +1,28 @@
+def double = { x -> x * 2 }
+def answer = { -> 42 }
+def add = { int a, b = 1 -> a + b }
+def noop = { }
+env.each { String k, v ->
+  echo "${k}=${v}"
+}
+def names = users.collect { it.name }.findAll { it }
+def stages = targets.collectEntries { target ->
+  def name = "build ${target}"
+  [build: {
+    stage(name) {
+      sh "make ${target}"
+    }
+  }]
+}
+parallel stages
+[1, 2, 3].each {
+  n ->
+  echo n
+}
+def result = withCredentials([string(credentialsId: 'x', variable: 'X')]) {
+  sh 'make'
+}
+switch (x) {
+  case { it > 10 }:
+    echo 'large'
+}
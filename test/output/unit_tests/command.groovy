@@ -0,0 +1,25 @@
+node {
+  foo(
+    a,
+    b: 1
+  )
+  sh script: 'make',
+    returnStdout: true
+  retry 3, {
+    sh 'x'
+  }
+  build job: 'x', parameters: [
+    string(name: 'A', value: 'a')
+  ], wait: false
+  please show the square_root of 100
+  def r = call(1, 2) + other(a: 1)
+  xs.each({ x -> echo x })
+  sh(script: 'make', returnStatus: true)
+  archive(includes: '*.jar')
+  def m = readJSON text: result
+  def ok = input message: 'Deploy?',
+    ok: 'yes'
+  env.OUT = sh script: 'ls', returnStdout: true
+  script.sh 'make'
+  steps.echo "done"
+}
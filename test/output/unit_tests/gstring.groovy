@@ -0,0 +1,7 @@
+def a = "${foo["bar"]}"
+def b = "x\\"
+def c = "${m.collect { k, v -> "${k}=${v}" }.join(',')}"
+def d = """multi ${x} "quoted" line
+"""
+echo "${a + b}"
+echo 'it\'s'
@@ -0,0 +1,11 @@
+foo(a, /* c */ b)
+x = /* c */ 1
+bar(x /* tail */) // line
+def m = [/* k */ key: /* v */ 'v']
+def l = [1, 2 /* two */]
+def e = [/* empty */]
+if (/* cond */ ok) { /* body */ }
+foo(
+  a, /* first */
+  b
+/* end */)
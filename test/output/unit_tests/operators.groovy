@@ -0,0 +1,41 @@
+pipeline {
+  script {
+    def a = !b
+    def c = x ? y : z
+    def d = x ?: 'default'
+    def e = env?.BRANCH_NAME
+    def f = files*.name
+    def m = (name =~ /re/)
+    def g = name ==~ /re-.*/
+    def h = a <=> b
+    list << 'x'
+    def i = 'a' in list
+    def j = x as String
+    def k = x instanceof java.util.Map
+    def l = x !instanceof Map || y !in list
+    count += 1
+    count -= 2
+    def n = a & b | c ^ ~d
+    def o = 1 << 2 >> 3 >>> 4
+    def p = 2 ** 10
+    def q = ++count + count--
+    def r = params.flag ? [a: 1] : [b: 2]
+    def s = items[0].name + m[0][1]
+    def t = a - 1
+    def u = A < b && c > d
+    (1..10).collect { it * 2 }
+    def part = a[1..2]
+    def init = a[0..<n]
+    def same = a === b || c !== d
+    def build = this.&build
+    def raw = obj.@name
+    x.y++
+    if (!params.skip && (a ? b : c)) {
+      echo "ok"
+    }
+    for (i = 0; i < 10; i += 2) {
+    }
+    for (e in ary) {
+    }
+  }
+}
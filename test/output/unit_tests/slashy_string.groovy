@@ -0,0 +1,17 @@
+def re = /ab\/c ${x}[0-9]+\d/
+def re2 = $/a/b$/c$$d \w+/$
+def half = total / 2
+def q = (a) / (b)
+script {
+  if (name.matches(/release-.*/)) {
+    echo "ok"
+  }
+  def m = [
+    pattern: /x+ y/,
+    other: $/
+  multi /line/
+/$
+  ]
+}
+def avg = xs.sum { it } / xs.size()
+def half = xs.collect { x -> x * 2 } / 2